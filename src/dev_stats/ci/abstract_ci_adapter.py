@@ -79,6 +79,40 @@ class AbstractCIAdapter(abc.ABC):
                     )
                 )
 
+            num_functions = f.num_functions + sum(c.num_methods for c in f.classes)
+            if num_functions > thresholds.max_file_functions:
+                results.append(
+                    Violation(
+                        rule="max_file_functions",
+                        message=(
+                            f"{f.path}: {num_functions} functions "
+                            f"exceeds limit of {thresholds.max_file_functions}"
+                        ),
+                        file_path=str(f.path),
+                        severity=ViolationSeverity.WARNING,
+                        value=float(num_functions),
+                        threshold=float(thresholds.max_file_functions),
+                    )
+                )
+
+            if thresholds.require_default_case:
+                results.extend(
+                    Violation(
+                        rule="require_default_case",
+                        message=(
+                            f"{f.path}:{site.line} {site.function or '<package>'}: "
+                            f"switch with {site.cases} case(s) has no default"
+                        ),
+                        file_path=str(f.path),
+                        line=site.line,
+                        column=site.column,
+                        severity=ViolationSeverity.WARNING,
+                        value=float(site.cases),
+                        symbol=site.function,
+                    )
+                    for site in f.missing_default_switches
+                )
+
             # Function-level checks
             for func in f.functions:
                 results.extend(self._check_function(func, str(f.path), thresholds))
@@ -98,6 +132,7 @@ class AbstractCIAdapter(abc.ABC):
                             line=cls.line,
                             severity=ViolationSeverity.WARNING,
                             value=float(cls.lines),
+                            symbol=cls.name,
                             threshold=float(thresholds.max_class_lines),
                         )
                     )
@@ -115,13 +150,37 @@ class AbstractCIAdapter(abc.ABC):
                             line=cls.line,
                             severity=ViolationSeverity.WARNING,
                             value=float(cls.num_methods),
+                            symbol=cls.name,
                             threshold=float(thresholds.max_class_methods),
                         )
                     )
 
+                num_specs = len(cls.interface_methods)
+                if "interface" in cls.decorators and num_specs > thresholds.max_interface_methods:
+                    results.append(
+                        Violation(
+                            rule="max_interface_methods",
+                            message=(
+                                f"{f.path}:{cls.line} interface {cls.name}: "
+                                f"{num_specs} methods exceeds limit of "
+                                f"{thresholds.max_interface_methods}"
+                            ),
+                            file_path=str(f.path),
+                            line=cls.line,
+                            severity=ViolationSeverity.WARNING,
+                            value=float(num_specs),
+                            symbol=cls.name,
+                            threshold=float(thresholds.max_interface_methods),
+                        )
+                    )
+
                 # Method-level checks
                 for method in cls.methods:
-                    results.extend(self._check_function(method, str(f.path), thresholds))
+                    results.extend(
+                        self._check_function(
+                            method, str(f.path), thresholds, symbol=f"{cls.name}.{method.name}"
+                        )
+                    )
 
         # Duplication check
         if self._report.duplication is not None:
@@ -165,6 +224,7 @@ class AbstractCIAdapter(abc.ABC):
         func: object,
         file_path: str,
         thresholds: object,
+        symbol: str | None = None,
     ) -> list[Violation]:
         """Check a function/method against thresholds.
 
@@ -172,6 +232,8 @@ class AbstractCIAdapter(abc.ABC):
             func: A MethodReport instance.
             file_path: Repository-relative file path.
             thresholds: ThresholdConfig instance.
+            symbol: Qualified name reported on violations (defaults to
+                the function name).
 
         Returns:
             List of violations for this function.
@@ -183,6 +245,7 @@ class AbstractCIAdapter(abc.ABC):
         assert isinstance(thresholds, ThresholdConfig)
 
         results: list[Violation] = []
+        symbol = symbol or func.name
 
         if func.lines > thresholds.max_function_lines:
             results.append(
@@ -195,6 +258,7 @@ class AbstractCIAdapter(abc.ABC):
                     ),
                     file_path=file_path,
                     line=func.line,
+                    symbol=symbol,
                     severity=ViolationSeverity.WARNING,
                     value=float(func.lines),
                     threshold=float(thresholds.max_function_lines),
@@ -212,6 +276,7 @@ class AbstractCIAdapter(abc.ABC):
                     ),
                     file_path=file_path,
                     line=func.line,
+                    symbol=symbol,
                     severity=ViolationSeverity.ERROR,
                     value=float(func.cyclomatic_complexity),
                     threshold=float(thresholds.max_cyclomatic_complexity),
@@ -229,6 +294,7 @@ class AbstractCIAdapter(abc.ABC):
                     ),
                     file_path=file_path,
                     line=func.line,
+                    symbol=symbol,
                     severity=ViolationSeverity.WARNING,
                     value=float(func.cognitive_complexity),
                     threshold=float(thresholds.max_cognitive_complexity),
@@ -246,12 +312,31 @@ class AbstractCIAdapter(abc.ABC):
                     ),
                     file_path=file_path,
                     line=func.line,
+                    symbol=symbol,
                     severity=ViolationSeverity.WARNING,
                     value=float(func.num_parameters),
                     threshold=float(thresholds.max_parameters),
                 )
             )
 
+        if func.return_count > thresholds.max_return_values:
+            results.append(
+                Violation(
+                    rule="max_return_values",
+                    message=(
+                        f"{file_path}:{func.line} {func.name}: "
+                        f"{func.return_count} return values exceeds "
+                        f"limit of {thresholds.max_return_values}"
+                    ),
+                    file_path=file_path,
+                    line=func.line,
+                    symbol=symbol,
+                    severity=ViolationSeverity.WARNING,
+                    value=float(func.return_count),
+                    threshold=float(thresholds.max_return_values),
+                )
+            )
+
         if func.nesting_depth > thresholds.max_nesting_depth:
             results.append(
                 Violation(
@@ -263,6 +348,7 @@ class AbstractCIAdapter(abc.ABC):
                     ),
                     file_path=file_path,
                     line=func.line,
+                    symbol=symbol,
                     severity=ViolationSeverity.WARNING,
                     value=float(func.nesting_depth),
                     threshold=float(thresholds.max_nesting_depth),
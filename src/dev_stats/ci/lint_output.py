@@ -0,0 +1,162 @@
+"""Linter-style rendering of violations for IDEs and CI log parsers."""
+
+from __future__ import annotations
+
+import re
+from typing import TYPE_CHECKING
+
+from dev_stats.ci.teamcity_adapter import escape_tc
+from dev_stats.ci.violation import ViolationSeverity
+
+if TYPE_CHECKING:
+    from collections.abc import Iterable
+    from typing import TextIO
+
+    from dev_stats.ci.violation import Violation
+
+LINT_FORMATS = ("text", "github-actions", "teamcity")
+"""Formats accepted by :func:`write_lint`."""
+
+# ``:42 `` left after the file path the adapters put in front of messages.
+_LOCATION_RE = re.compile(r"^:\d*\s*")
+
+_GITHUB_LEVELS = {
+    ViolationSeverity.ERROR: "error",
+    ViolationSeverity.WARNING: "warning",
+    ViolationSeverity.INFO: "notice",
+}
+
+
+def write_lint(stream: TextIO, violations: Iterable[Violation], fmt: str) -> None:
+    """Write one line per violation in a ``golangci-lint``-compatible format.
+
+    ``text`` is ``file:line:col: message (rule)``, the format editors
+    already match for compiler and linter output.  ``github-actions``
+    emits ``::error file=...,line=...,col=...::message`` workflow
+    commands and ``teamcity`` ``##teamcity[inspection ...]`` service
+    messages, preceded by one ``inspectionType`` per rule.  Line and
+    column default to ``1`` for file-level violations; repo-wide
+    violations have no location and are written without one.  The
+    ``file:line`` prefix the adapters put on messages is dropped, since
+    every format carries the location separately.
+
+    Args:
+        stream: Destination, e.g. :data:`sys.stdout`.
+        violations: Violations to render, in order.
+        fmt: One of :data:`LINT_FORMATS`.
+
+    Raises:
+        ValueError: If *fmt* is not a known format.
+    """
+    if fmt not in LINT_FORMATS:
+        msg = f"unknown lint format {fmt!r}; expected one of {', '.join(LINT_FORMATS)}"
+        raise ValueError(msg)
+    items = list(violations)
+    if fmt == "text":
+        lines = [_text_line(v) for v in items]
+    elif fmt == "github-actions":
+        lines = [_github_line(v) for v in items]
+    else:
+        lines = _teamcity_lines(items)
+    stream.writelines(f"{line}\n" for line in lines)
+
+
+def _message(v: Violation) -> str:
+    """Return the message of *v* without its leading location.
+
+    Args:
+        v: The violation.
+
+    Returns:
+        ``"F: 8 parameters ..."`` for ``"a.go:3 F: 8 parameters ..."``;
+        messages without the prefix are returned unchanged.
+    """
+    if not v.file_path or not v.message.startswith(f"{v.file_path}:"):
+        return v.message
+    return _LOCATION_RE.sub("", v.message[len(v.file_path) :], count=1)
+
+
+def _text_line(v: Violation) -> str:
+    """Render *v* as ``file:line:col: message (rule)``.
+
+    Args:
+        v: The violation.
+
+    Returns:
+        One output line.
+    """
+    if not v.file_path:
+        return f"{v.message} ({v.rule})"
+    return f"{v.file_path}:{max(v.line, 1)}:{max(v.column, 1)}: {_message(v)} ({v.rule})"
+
+
+def _github_line(v: Violation) -> str:
+    """Render *v* as a GitHub Actions workflow command.
+
+    Args:
+        v: The violation.
+
+    Returns:
+        One ``::level ...::message`` line.
+    """
+    params = [f"title={_escape_property(v.rule)}"]
+    if v.file_path:
+        params[:0] = [
+            f"file={_escape_property(v.file_path)}",
+            f"line={max(v.line, 1)}",
+            f"col={max(v.column, 1)}",
+        ]
+    return f"::{_GITHUB_LEVELS[v.severity]} {','.join(params)}::{_escape_data(_message(v))}"
+
+
+def _teamcity_lines(violations: list[Violation]) -> list[str]:
+    """Render *violations* as TeamCity inspection service messages.
+
+    Args:
+        violations: The violations.
+
+    Returns:
+        ``inspectionType`` lines, one per rule in first-seen order,
+        followed by one ``inspection`` line per violation.
+    """
+    lines: list[str] = []
+    for rule in dict.fromkeys(v.rule for v in violations):
+        rule_id = escape_tc(rule)
+        lines.append(
+            f"##teamcity[inspectionType id='{rule_id}' name='{rule_id}' "
+            f"category='dev-stats' description='{rule_id}']"
+        )
+    for v in violations:
+        severity = "ERROR" if v.severity == ViolationSeverity.ERROR else "WARNING"
+        location = ""
+        if v.file_path:
+            location = f"file='{escape_tc(v.file_path)}' line='{max(v.line, 1)}' "
+        lines.append(
+            f"##teamcity[inspection typeId='{escape_tc(v.rule)}' "
+            f"message='{escape_tc(_message(v))}' {location}SEVERITY='{severity}']"
+        )
+    return lines
+
+
+def _escape_data(value: str) -> str:
+    """Escape a GitHub workflow command message.
+
+    Args:
+        value: Raw message.
+
+    Returns:
+        The message with ``%``, CR and LF percent-encoded.
+    """
+    return value.replace("%", "%25").replace("\r", "%0D").replace("\n", "%0A")
+
+
+def _escape_property(value: str) -> str:
+    """Escape a GitHub workflow command property value.
+
+    Args:
+        value: Raw value.
+
+    Returns:
+        The value with ``:`` and ``,`` also percent-encoded.
+    """
+    return _escape_data(value).replace(":", "%3A").replace(",", "%2C")
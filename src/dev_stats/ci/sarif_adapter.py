@@ -0,0 +1,124 @@
+"""SARIF 2.1.0 adapter for GitHub code scanning and other SARIF consumers."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING
+
+from dev_stats import __version__
+from dev_stats.ci.abstract_ci_adapter import AbstractCIAdapter
+from dev_stats.ci.violation import ViolationSeverity
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+    from dev_stats.ci.violation import Violation
+
+_SCHEMA = "https://json.schemastore.org/sarif-2.1.0.json"
+_INFORMATION_URI = "https://github.com/filthyhuman/dev-stats"
+
+# Map our severity levels to SARIF result levels.
+_LEVEL_MAP: dict[ViolationSeverity, str] = {
+    ViolationSeverity.INFO: "note",
+    ViolationSeverity.WARNING: "warning",
+    ViolationSeverity.ERROR: "error",
+}
+
+
+class SarifAdapter(AbstractCIAdapter):
+    """Produces a SARIF 2.1.0 log (``dev-stats.sarif``).
+
+    The log has a single run whose ``tool.driver.rules`` lists every rule
+    that fired.  Each violation becomes a ``result`` whose physical
+    location is relative to the ``%SRCROOT%`` base, which is what
+    ``github/codeql-action/upload-sarif`` expects.  Repository-wide
+    violations have no location.
+    """
+
+    def emit(self) -> str:
+        """Emit violations as a SARIF JSON string.
+
+        Returns:
+            SARIF 2.1.0 log as JSON.
+        """
+        rule_ids = sorted({v.rule for v in self._violations})
+        log = {
+            "$schema": _SCHEMA,
+            "version": "2.1.0",
+            "runs": [
+                {
+                    "tool": {
+                        "driver": {
+                            "name": "dev-stats",
+                            "version": __version__,
+                            "informationUri": _INFORMATION_URI,
+                            "rules": [
+                                {"id": rule, "shortDescription": {"text": rule}}
+                                for rule in rule_ids
+                            ],
+                        }
+                    },
+                    "originalUriBaseIds": {"%SRCROOT%": {"uri": self._root_uri()}},
+                    "results": [self._result(v, rule_ids.index(v.rule)) for v in self._violations],
+                }
+            ],
+        }
+        return json.dumps(log, indent=2, ensure_ascii=False)
+
+    def write_report(self, output_dir: Path) -> list[Path]:
+        """Write the SARIF log to *output_dir*.
+
+        Args:
+            output_dir: Directory to write into.
+
+        Returns:
+            Single-element list with the path to the SARIF file.
+        """
+        output_dir.mkdir(parents=True, exist_ok=True)
+        out_path = output_dir / "dev-stats.sarif"
+        out_path.write_text(self.emit(), encoding="utf-8")
+        return [out_path]
+
+    def _root_uri(self) -> str:
+        """Return the repository root as a ``file://`` URI ending in ``/``.
+
+        Returns:
+            Absolute URI of the analysed root.
+        """
+        uri = self._report.root.resolve().as_uri()
+        return uri if uri.endswith("/") else f"{uri}/"
+
+    @staticmethod
+    def _result(violation: Violation, rule_index: int) -> dict[str, object]:
+        """Convert one violation into a SARIF ``result`` object.
+
+        Args:
+            violation: The violation to convert.
+            rule_index: Index of its rule in ``tool.driver.rules``.
+
+        Returns:
+            SARIF result dictionary.
+        """
+        result: dict[str, object] = {
+            "ruleId": violation.rule,
+            "ruleIndex": rule_index,
+            "level": _LEVEL_MAP.get(violation.severity, "warning"),
+            "message": {"text": violation.message},
+        }
+        if violation.file_path:
+            physical: dict[str, object] = {
+                "artifactLocation": {
+                    "uri": violation.file_path.replace("\\", "/"),
+                    "uriBaseId": "%SRCROOT%",
+                },
+            }
+            if violation.line > 0:
+                region: dict[str, int] = {"startLine": violation.line}
+                if violation.column > 0:
+                    region["startColumn"] = violation.column
+                physical["region"] = region
+            location: dict[str, object] = {"physicalLocation": physical}
+            if violation.symbol:
+                location["logicalLocations"] = [{"name": violation.symbol}]
+            result["locations"] = [location]
+        return result
@@ -11,7 +11,7 @@ if TYPE_CHECKING:
     from pathlib import Path
 
 
-def _escape_tc(value: str) -> str:
+def escape_tc(value: str) -> str:
     """Escape a string for TeamCity service message values.
 
     Args:
@@ -66,10 +66,10 @@ class TeamCityAdapter(AbstractCIAdapter):
                 seen_rules.add(v.rule)
                 lines.append(
                     f"##teamcity[inspectionType "
-                    f"id='{_escape_tc(v.rule)}' "
-                    f"name='{_escape_tc(v.rule)}' "
+                    f"id='{escape_tc(v.rule)}' "
+                    f"name='{escape_tc(v.rule)}' "
                     f"category='dev-stats' "
-                    f"description='{_escape_tc(v.rule)}']"
+                    f"description='{escape_tc(v.rule)}']"
                 )
 
         # Emit individual inspections
@@ -77,9 +77,9 @@ class TeamCityAdapter(AbstractCIAdapter):
             severity = "WARNING" if v.severity == ViolationSeverity.WARNING else "ERROR"
             lines.append(
                 f"##teamcity[inspection "
-                f"typeId='{_escape_tc(v.rule)}' "
-                f"message='{_escape_tc(v.message)}' "
-                f"file='{_escape_tc(v.file_path)}' "
+                f"typeId='{escape_tc(v.rule)}' "
+                f"message='{escape_tc(v.message)}' "
+                f"file='{escape_tc(v.file_path)}' "
                 f"line='{v.line}' "
                 f"SEVERITY='{severity}']"
             )
@@ -89,8 +89,8 @@ class TeamCityAdapter(AbstractCIAdapter):
             if v.severity == ViolationSeverity.ERROR:
                 lines.append(
                     f"##teamcity[buildProblem "
-                    f"description='{_escape_tc(v.message)}' "
-                    f"identity='{_escape_tc(v.rule + ':' + v.file_path)}']"
+                    f"description='{escape_tc(v.message)}' "
+                    f"identity='{escape_tc(v.rule + ':' + v.file_path)}']"
                 )
 
         return "\n".join(lines)
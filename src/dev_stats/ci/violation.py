@@ -23,15 +23,20 @@ class Violation:
         message: Human-readable description of the violation.
         file_path: Repository-relative file path, or empty for repo-wide.
         line: Line number (0 when not applicable).
+        column: Column number (0 when not applicable).
         severity: Severity level.
         value: The measured value that triggered the violation.
         threshold: The threshold that was exceeded.
+        symbol: Offending function (``Class.method`` for methods) or class
+            name, or empty for file- and repo-wide violations.
     """
 
     rule: str
     message: str
     file_path: str = ""
     line: int = 0
+    column: int = 0
     severity: ViolationSeverity = ViolationSeverity.WARNING
     value: float = 0.0
     threshold: float = 0.0
+    symbol: str = ""
@@ -4,6 +4,7 @@ from __future__ import annotations
 
 import logging
 import subprocess
+import sys
 from pathlib import Path
 from typing import TYPE_CHECKING, Annotated
 
@@ -11,27 +12,35 @@ import typer
 from rich.console import Console
 from rich.progress import BarColumn, Progress, SpinnerColumn, TaskProgressColumn, TextColumn
 
+from dev_stats.ci.lint_output import LINT_FORMATS, write_lint
 from dev_stats.config.analysis_config import AnalysisConfig
 from dev_stats.core.aggregator import Aggregator
 from dev_stats.core.dispatcher import Dispatcher
+from dev_stats.core.go_mod_reader import GoModReader
 from dev_stats.core.git.branch_analyzer import BranchAnalyzer
 from dev_stats.core.git.commit_enricher import CommitEnricher
 from dev_stats.core.git.contributor_analyzer import ContributorAnalyzer
 from dev_stats.core.git.log_harvester import LogHarvester
 from dev_stats.core.git.pattern_detector import PatternDetector
 from dev_stats.core.git.timeline_builder import TimelineBuilder
+from dev_stats.core.metrics.test_coverage_reader import TestCoverageReader
+from dev_stats.core.parse_cache import DiskParseCache
 from dev_stats.core.parser_registry import create_default_registry
 from dev_stats.core.scanner import Scanner
+from dev_stats.core.source_annotator import SourceAnnotator
 from dev_stats.output.dashboard.dashboard_builder import DashboardBuilder
 from dev_stats.output.exporters.badge_generator import BadgeGenerator
 from dev_stats.output.exporters.csv_exporter import CsvExporter
+from dev_stats.output.exporters.html_exporter import HtmlExporter
 from dev_stats.output.exporters.json_exporter import JsonExporter
+from dev_stats.output.exporters.prometheus_exporter import PrometheusExporter
 from dev_stats.output.exporters.terminal_reporter import TerminalReporter
 from dev_stats.output.exporters.xml_exporter import XmlExporter
 
 if TYPE_CHECKING:
     from dev_stats.ci.abstract_ci_adapter import AbstractCIAdapter
     from dev_stats.core.models import FileReport, RepoReport
+    from dev_stats.core.parser_registry import ParserRegistry
 
 logger = logging.getLogger(__name__)
 
@@ -58,14 +67,27 @@ class AnalyseCommand:
             typer.Option(
                 "--format",
                 "-f",
-                help="Output format: json | csv | xml | badges | dashboard | all.",
+                help=(
+                    "Output format: json | csv | xml | html | badges | dashboard | "
+                    "prometheus | all."
+                ),
             ),
         ] = None,
         ci: Annotated[
             str | None,
             typer.Option(
                 "--ci",
-                help="CI format: jenkins | gitlab | teamcity | github.",
+                help="CI format: jenkins | gitlab | teamcity | github | sarif.",
+            ),
+        ] = None,
+        lint: Annotated[
+            str | None,
+            typer.Option(
+                "--lint",
+                help=(
+                    "Print violations linter-style: text | github-actions | teamcity. "
+                    "Only lint lines go to stdout; progress goes to stderr."
+                ),
             ),
         ] = None,
         config: Annotated[
@@ -92,6 +114,44 @@ class AnalyseCommand:
             list[str] | None,
             typer.Option("--lang", "-l", help="Language filter."),
         ] = None,
+        recursive: Annotated[
+            bool | None,
+            typer.Option(
+                "--recursive/--no-recursive",
+                help="Scan subdirectories (default) or only the top-level directory.",
+            ),
+        ] = None,
+        exclude_tests: Annotated[
+            bool,
+            typer.Option("--exclude-tests", help="Skip test files (*_test.go, test_*.py, ...)."),
+        ] = False,
+        exclude_generated: Annotated[
+            bool,
+            typer.Option(
+                "--exclude-generated",
+                help="Skip files with a 'Code generated' header.",
+            ),
+        ] = False,
+        jobs: Annotated[
+            int | None,
+            typer.Option(
+                "--jobs",
+                "-j",
+                min=0,
+                help="Parallel parse workers (0 = one per CPU).",
+            ),
+        ] = None,
+        cache_dir: Annotated[
+            Path | None,
+            typer.Option(
+                "--cache-dir",
+                help="Reuse parse results for unchanged files (keyed by content hash).",
+            ),
+        ] = None,
+        module: Annotated[
+            bool,
+            typer.Option("--module", help="Also report the Go module from go.mod."),
+        ] = False,
         diff: Annotated[
             str | None,
             typer.Option("--diff", help="Compare against a branch or commit."),
@@ -103,6 +163,71 @@ class AnalyseCommand:
                 help="Exit non-zero when violations are found.",
             ),
         ] = False,
+        max_cc: Annotated[
+            int | None,
+            typer.Option(
+                "--max-cc",
+                min=1,
+                help="Cyclomatic-complexity limit; implies --fail-on-violations.",
+            ),
+        ] = None,
+        max_nesting: Annotated[
+            int | None,
+            typer.Option(
+                "--max-nesting",
+                min=1,
+                help="Control-flow nesting limit; implies --fail-on-violations.",
+            ),
+        ] = None,
+        max_params: Annotated[
+            int | None,
+            typer.Option(
+                "--max-params",
+                min=1,
+                help="Parameter-count limit; implies --fail-on-violations.",
+            ),
+        ] = None,
+        max_returns: Annotated[
+            int | None,
+            typer.Option(
+                "--max-returns",
+                min=1,
+                help="Return-value limit; implies --fail-on-violations.",
+            ),
+        ] = None,
+        max_interface_methods: Annotated[
+            int | None,
+            typer.Option(
+                "--max-interface-methods",
+                min=1,
+                help="Methods-per-interface limit; implies --fail-on-violations.",
+            ),
+        ] = None,
+        require_default_case: Annotated[
+            bool,
+            typer.Option(
+                "--require-default-case",
+                help="Flag switches without a default case; implies --fail-on-violations.",
+            ),
+        ] = False,
+        annotate: Annotated[
+            bool,
+            typer.Option(
+                "--annotate",
+                help="Write '// devstats: cc=N loc=M' comments onto Go function signatures.",
+            ),
+        ] = False,
+        dry_run: Annotated[
+            bool,
+            typer.Option("--dry-run", help="With --annotate, list changes without writing."),
+        ] = False,
+        coverprofile: Annotated[
+            Path | None,
+            typer.Option(
+                "--coverprofile",
+                help="Go 'go test -coverprofile' output; lists untested functions.",
+            ),
+        ] = None,
         watch: Annotated[
             bool,
             typer.Option("--watch", "-w", help="Re-run on file changes."),
@@ -117,21 +242,63 @@ class AnalyseCommand:
         Args:
             repo: Path to the repository.
             output: Optional output directory for exports.
-            fmt: Output format (json, csv, xml, badges, dashboard, all).
+            fmt: Output format (json, csv, xml, html, badges, dashboard,
+                prometheus, all).
             ci: Optional CI adapter name.
+            lint: Optional linter output format, see :data:`LINT_FORMATS`.
+                Stdout then carries only the lint lines: progress goes to
+                stderr and the terminal report is skipped.
             config: Optional TOML config file path.
             exclude: Glob patterns to exclude.
             top: Number of top items in tables.
             sort: Sort key for the files table (lines, code, complexity, name).
             lang: Language filter list.
+            recursive: Whether to scan subdirectories (``None`` = config value).
+            exclude_tests: Skip test files.
+            exclude_generated: Skip generated files.
+            jobs: Parse worker count (``None`` = config value).
+            cache_dir: Parse-cache directory (``None`` = config value).
+            module: Read ``go.mod`` in the repository root.
             diff: Branch or commit to diff against.
             fail_on_violations: Whether to fail on violations.
+            max_cc: Override for ``thresholds.max_cyclomatic_complexity``;
+                enables the quality gate.
+            max_nesting: Override for ``thresholds.max_nesting_depth``;
+                enables the quality gate.
+            max_params: Override for ``thresholds.max_parameters``;
+                enables the quality gate.
+            max_returns: Override for ``thresholds.max_return_values``;
+                enables the quality gate.
+            max_interface_methods: Override for
+                ``thresholds.max_interface_methods``; enables the quality gate.
+            require_default_case: Enable ``thresholds.require_default_case``
+                and the quality gate.
+            annotate: Rewrite Go files in place with metric comments.
+            dry_run: Report what ``annotate`` would change without writing.
+            coverprofile: Go cover profile marking functions as covered.
             watch: Re-run on file changes.
             since: Date filter for commits.
         """
-        console = Console()
+        # Keep stdout clean for problem matchers reading --lint output.
+        console = Console(stderr=lint is not None)
         repo_path = repo.resolve()
         _fail_exit = False
+        threshold_overrides = {
+            "max_cyclomatic_complexity": max_cc,
+            "max_nesting_depth": max_nesting,
+            "max_parameters": max_params,
+            "max_return_values": max_returns,
+            "max_interface_methods": max_interface_methods,
+            "require_default_case": True if require_default_case else None,
+        }
+        threshold_overrides = {k: v for k, v in threshold_overrides.items() if v is not None}
+        gate = fail_on_violations or bool(threshold_overrides)
+        if lint is not None and lint not in LINT_FORMATS:
+            console.print(
+                f"[red]Error:[/red] unknown lint format {lint!r}; "
+                f"expected one of {', '.join(LINT_FORMATS)}"
+            )
+            raise typer.Exit(code=1)
 
         try:
             console.print("[bold]Loading configuration...[/bold]")
@@ -140,22 +307,44 @@ class AnalyseCommand:
                 repo_path=repo_path,
                 exclude_patterns=tuple(exclude) if exclude else None,
                 languages=tuple(lang) if lang else None,
+                recursive=recursive,
+                exclude_tests=True if exclude_tests else None,
+                exclude_generated=True if exclude_generated else None,
+                jobs=jobs,
+                cache_dir=cache_dir,
             )
             if top != 20:
                 analysis_config = analysis_config.model_copy(
                     update={"output": analysis_config.output.model_copy(update={"top_n": top})}
                 )
+            if threshold_overrides:
+                thresholds = analysis_config.thresholds.model_copy(update=threshold_overrides)
+                analysis_config = analysis_config.model_copy(update={"thresholds": thresholds})
+
+            go_module = None
+            if module:
+                go_module = GoModReader().read(repo_path)
+                console.print(
+                    f"  Go module {go_module.module_path}: "
+                    f"{go_module.num_direct_dependencies} direct, "
+                    f"{go_module.num_indirect_dependencies} indirect dependencies"
+                )
 
             # Scan
             console.print("[bold]Scanning files...[/bold]")
-            scanner = Scanner(repo_path=repo_path, config=analysis_config)
+            registry = create_default_registry()
+            extensions = self._language_extensions(registry, analysis_config.languages)
+            scanner = Scanner(
+                repo_path=repo_path,
+                config=analysis_config,
+                extensions=extensions if analysis_config.languages else None,
+            )
             paths = list(scanner.scan())
             console.print(f"  Found {len(paths)} file(s)")
 
             # Parse
-            registry = create_default_registry()
-            dispatcher = Dispatcher(registry=registry, repo_root=repo_path)
-            file_reports: list[FileReport] = []
+            cache = DiskParseCache(analysis_config.cache_dir) if analysis_config.cache_dir else None
+            dispatcher = Dispatcher(registry=registry, repo_root=repo_path, cache=cache)
             with Progress(
                 SpinnerColumn(),
                 TextColumn("[bold]Parsing files...[/bold]"),
@@ -166,14 +355,21 @@ class AnalyseCommand:
                 transient=True,
             ) as progress:
                 task = progress.add_task("Parsing", total=len(paths))
-                for path in paths:
-                    try:
-                        file_reports.append(dispatcher.parse(path))
-                    except (SyntaxError, OSError, ValueError, UnicodeDecodeError):
-                        logger.exception("Failed to parse %s", path)
-                    progress.advance(task)
+                file_reports = dispatcher.parse_many(
+                    paths,
+                    jobs=analysis_config.jobs,
+                    on_progress=lambda: progress.advance(task),
+                )
             console.print(f"  Parsed {len(file_reports)} file(s)")
 
+            if coverprofile is not None:
+                file_reports = self._apply_coverprofile(
+                    file_reports, coverprofile, repo_path, console
+                )
+
+            if annotate:
+                self._annotate_sources(file_reports, repo_path, dry_run=dry_run, console=console)
+
             # Git analysis
             commits = None
             enriched = None
@@ -237,12 +433,13 @@ class AnalyseCommand:
                     contributors=contributors,
                     patterns=patterns,
                     timeline=timeline,
+                    go_module=go_module,
                 )
                 progress.advance(task)
             console.print("  Aggregated results")
 
-            # Terminal output (always shown unless format-only)
-            if fmt is None:
+            # Terminal output (shown unless format-only or linting)
+            if fmt is None and lint is None:
                 console.print("[bold]Generating terminal report...[/bold]")
                 reporter = TerminalReporter(
                     report=report,
@@ -266,45 +463,17 @@ class AnalyseCommand:
                     console.print(f"  [green]wrote[/green] {p}")
 
             # CI adapter
-            if ci is not None or fail_on_violations:
-                console.print("[bold]Checking quality gates...[/bold]")
-                adapter = self._create_ci_adapter(
-                    name=ci or "github",
-                    report=report,
-                    config=analysis_config,
+            if ci is not None or gate or lint is not None:
+                _fail_exit = self._check_quality_gates(
+                    report,
+                    analysis_config,
+                    ci=ci,
+                    gate=gate,
+                    lint=lint,
+                    diff=diff,
+                    output_dir=output if output is not None else repo_path / "dev-stats-output",
+                    console=console,
                 )
-                adapter.check_violations()
-
-                # Delta mode: filter to changed files only
-                if diff is not None:
-                    diff_files = self._get_diff_files(repo_path, diff)
-                    adapter._violations = tuple(
-                        v
-                        for v in adapter.violations
-                        if not v.file_path or v.file_path in diff_files
-                    )
-
-                if ci is not None:
-                    console.print(adapter.emit(), markup=False, highlight=False)
-                    ci_output_dir = output if output is not None else repo_path / "dev-stats-output"
-                    created_ci = adapter.write_report(ci_output_dir)
-                    for p in created_ci:
-                        console.print(f"  [green]wrote[/green] {p}")
-
-                if fail_on_violations and adapter.violations:
-                    from dev_stats.ci.violation import ViolationSeverity
-
-                    error_count = sum(
-                        1 for v in adapter.violations if v.severity == ViolationSeverity.ERROR
-                    )
-                    warn_count = sum(
-                        1 for v in adapter.violations if v.severity == ViolationSeverity.WARNING
-                    )
-                    console.print(
-                        f"[red]Quality gate failed:[/red] "
-                        f"{error_count} error(s), {warn_count} warning(s)"
-                    )
-                    _fail_exit = True
 
         except FileNotFoundError as exc:
             console.print(f"[red]Error:[/red] {exc}")
@@ -316,30 +485,239 @@ class AnalyseCommand:
 
         if watch:
             from dev_stats.cli.watch_runner import WatchRunner
+            from dev_stats.core.report_updater import ReportUpdater
+
+            updater = ReportUpdater(report=report, dispatcher=dispatcher, scanner=scanner)
+            failed = [_fail_exit]
+
+            def on_changes(changed: set[Path]) -> None:
+                """Merge *changed* files into the report, re-check gates and export."""
+                updated = updater.apply(changed)
+                names = ", ".join(
+                    sorted(p.relative_to(repo_path).as_posix() for p in changed if p.is_absolute())
+                )
+                console.print(f"Updated {names} — {self._summary(updated)}", markup=False)
+                if fmt is not None:
+                    self._run_exporters(
+                        fmt=fmt,
+                        report=updated,
+                        config=analysis_config,
+                        output_dir=output if output is not None else repo_path / "dev-stats-output",
+                        console=console,
+                    )
+                if ci is not None or gate or lint is not None:
+                    failed[0] = self._check_quality_gates(
+                        updated,
+                        analysis_config,
+                        ci=ci,
+                        gate=gate,
+                        lint=lint,
+                        diff=diff,
+                        output_dir=output if output is not None else repo_path / "dev-stats-output",
+                        console=console,
+                    )
 
             watch_runner = WatchRunner(
                 repo_path=repo_path,
-                run_analysis=lambda: self(
-                    repo,
-                    output=output,
-                    fmt=fmt,
-                    ci=ci,
-                    config=config,
-                    exclude=exclude,
-                    top=top,
-                    sort=sort,
-                    lang=lang,
-                    diff=diff,
-                    fail_on_violations=fail_on_violations,
-                    watch=False,
-                    since=since,
+                run_analysis=lambda: console.print(
+                    f"Analysed — {self._summary(updater.report)}", markup=False
                 ),
+                extensions=extensions,
+                on_changes=on_changes,
             )
             watch_runner.run()
+            # The exit code reflects the gates of the last update before Ctrl-C.
+            _fail_exit = failed[0]
 
         if _fail_exit:
             raise typer.Exit(code=1)
 
+    def _check_quality_gates(
+        self,
+        report: RepoReport,
+        config: AnalysisConfig,
+        *,
+        ci: str | None,
+        gate: bool,
+        lint: str | None,
+        diff: str | None,
+        output_dir: Path,
+        console: Console,
+    ) -> bool:
+        """Check thresholds and emit CI, lint and gate output for *report*.
+
+        Args:
+            report: The analysed report.
+            config: Analysis configuration with the thresholds.
+            ci: CI adapter name, or ``None`` for no CI report.
+            gate: Whether violations fail the run.
+            lint: Linter output format, or ``None``.
+            diff: Only keep violations in files changed since this ref.
+            output_dir: Directory for CI report files.
+            console: Console for messages.
+
+        Returns:
+            ``True`` if the gate is enabled and violations were found.
+        """
+        console.print("[bold]Checking quality gates...[/bold]")
+        adapter = self._create_ci_adapter(
+            name=ci or "github",
+            report=report,
+            config=config,
+        )
+        adapter.check_violations()
+
+        # Delta mode: filter to changed files only
+        if diff is not None:
+            diff_files = self._get_diff_files(report.root, diff)
+            adapter._violations = tuple(
+                v for v in adapter.violations if not v.file_path or v.file_path in diff_files
+            )
+
+        if lint is not None:
+            write_lint(sys.stdout, adapter.violations, lint)
+
+        if ci is not None:
+            console.print(adapter.emit(), markup=False, highlight=False)
+            for p in adapter.write_report(output_dir):
+                console.print(f"  [green]wrote[/green] {p}")
+
+        if not (gate and adapter.violations):
+            return False
+
+        from dev_stats.ci.violation import ViolationSeverity
+
+        if ci is None and lint is None:
+            for v in adapter.violations:
+                console.print(f"  {v.severity.value}: {v.message}", markup=False, highlight=False)
+
+        error_count = sum(1 for v in adapter.violations if v.severity == ViolationSeverity.ERROR)
+        warn_count = sum(1 for v in adapter.violations if v.severity == ViolationSeverity.WARNING)
+        console.print(
+            f"[red]Quality gate failed:[/red] {error_count} error(s), {warn_count} warning(s)"
+        )
+        return True
+
+    @staticmethod
+    def _language_extensions(
+        registry: ParserRegistry, languages: tuple[str, ...]
+    ) -> frozenset[str]:
+        """Return the file extensions of the selected languages.
+
+        Args:
+            registry: Parser registry mapping extensions to languages.
+            languages: ``--lang`` names; empty selects every language.
+
+        Returns:
+            The registered extensions of *languages*.
+        """
+        by_language = registry.supported_languages()
+        if not languages:
+            return frozenset(ext for exts in by_language.values() for ext in exts)
+        wanted = {lang.lower() for lang in languages}
+        return frozenset(
+            ext for lang, exts in by_language.items() if lang.lower() in wanted for ext in exts
+        )
+
+    @staticmethod
+    def _summary(report: RepoReport) -> str:
+        """Return the one-line summary printed by ``--watch``.
+
+        Args:
+            report: The current report.
+
+        Returns:
+            File, line and function counts.
+        """
+        functions = sum(
+            f.num_functions + sum(c.num_methods for c in f.classes) for f in report.files
+        )
+        lines = sum(f.total_lines for f in report.files)
+        return f"{len(report.files)} files, {lines} lines, {functions} functions"
+
+    @staticmethod
+    def _apply_coverprofile(
+        file_reports: list[FileReport], profile_path: Path, repo_path: Path, console: Console
+    ) -> list[FileReport]:
+        """Mark Go functions as covered from a cover profile and list the rest.
+
+        Profile entries are matched by import path: the module path from
+        ``go.mod`` in *repo_path* is stripped and the rest compared with
+        each report path exactly.
+
+        Args:
+            file_reports: Parsed files; only Go files are annotated.
+            profile_path: ``go test -coverprofile`` output.
+            repo_path: Repository root, holding ``go.mod``.
+            console: Console for the untested-function list.
+
+        Returns:
+            The file reports with ``covered`` set where the profile has data.
+
+        Raises:
+            FileNotFoundError: If *profile_path* does not exist.
+            ValueError: If the profile is malformed.
+        """
+        reader = TestCoverageReader()
+        profile = reader.load_cover_profile(profile_path)
+        module_path = (
+            GoModReader().read(repo_path).module_path if (repo_path / "go.mod").is_file() else ""
+        )
+        annotated = [
+            reader.annotate_with_coverage(f, profile, module_path) if f.language == "go" else f
+            for f in file_reports
+        ]
+        untested = [(f.path, name) for f in annotated for name in f.uncovered_functions]
+        known = sum(
+            1 for f in annotated for _, fn in f.qualified_functions if fn.covered is not None
+        )
+        console.print(f"  Coverage: {len(untested)} of {known} function(s) untested")
+        for path, name in untested:
+            console.print(f"    {path.as_posix()}: {name}", markup=False)
+        return annotated
+
+    @staticmethod
+    def _annotate_sources(
+        file_reports: list[FileReport],
+        repo_path: Path,
+        *,
+        dry_run: bool,
+        console: Console,
+    ) -> None:
+        """Write metric comments into the Go files of *file_reports*.
+
+        Files are read and written as raw UTF-8 so their line endings are
+        kept; unchanged files are not rewritten.  A file the annotator
+        refuses (a signature line ending inside a literal) is left alone.
+
+        Args:
+            file_reports: Parsed files; non-Go files are skipped.
+            repo_path: Repository root the report paths are relative to.
+            dry_run: Only print the files that would change.
+            console: Console for progress messages.
+        """
+        annotator = SourceAnnotator()
+        changed = 0
+        for f in file_reports:
+            if f.language != "go" or not f.qualified_functions:
+                continue
+            path = repo_path / f.path
+            source = path.read_bytes().decode("utf-8")
+            try:
+                annotated = annotator.annotate(source, f)
+            except ValueError as exc:
+                console.print(f"  ⚠ skipped {exc}", markup=False)
+                continue
+            if annotated == source:
+                continue
+            changed += 1
+            if dry_run:
+                console.print(f"  would annotate {f.path.as_posix()}", markup=False)
+            else:
+                path.write_bytes(annotated.encode("utf-8"))
+        verb = "Would annotate" if dry_run else "Annotated"
+        console.print(f"  {verb} {changed} file(s)")
+
     @staticmethod
     def _run_exporters(
         fmt: str,
@@ -351,7 +729,8 @@ class AnalyseCommand:
         """Dispatch to the requested exporter(s).
 
         Args:
-            fmt: Format string (json, csv, xml, badges, dashboard, all).
+            fmt: Format string (json, csv, xml, html, badges, dashboard,
+                prometheus, all).
             report: The RepoReport.
             config: The AnalysisConfig.
             output_dir: Directory to write exports into.
@@ -360,7 +739,11 @@ class AnalyseCommand:
         Returns:
             List of paths to generated files.
         """
-        formats = {fmt} if fmt != "all" else {"json", "csv", "xml", "badges", "dashboard"}
+        formats = (
+            {fmt}
+            if fmt != "all"
+            else {"json", "csv", "xml", "html", "badges", "dashboard", "prometheus"}
+        )
         created: list[Path] = []
 
         if "json" in formats:
@@ -380,11 +763,21 @@ class AnalyseCommand:
             exporter_xml = XmlExporter(report=report, config=config)
             created.extend(exporter_xml.export(output_dir))
 
+        if "html" in formats:
+            console.print("[bold]Exporting HTML...[/bold]")
+            exporter_html = HtmlExporter(report=report, config=config)
+            created.extend(exporter_html.export(output_dir))
+
         if "badges" in formats:
             console.print("[bold]Generating badges...[/bold]")
             badge_gen = BadgeGenerator(report=report, config=config)
             created.extend(badge_gen.export(output_dir))
 
+        if "prometheus" in formats:
+            console.print("[bold]Exporting Prometheus metrics...[/bold]")
+            prometheus = PrometheusExporter(report=report, config=config)
+            created.extend(prometheus.export(output_dir))
+
         if "dashboard" in formats:
             with Progress(
                 SpinnerColumn(),
@@ -410,7 +803,7 @@ class AnalyseCommand:
         """Create a CI adapter by name.
 
         Args:
-            name: Adapter name (jenkins, gitlab, teamcity, github).
+            name: Adapter name (jenkins, gitlab, teamcity, github, sarif).
             report: The analysis report.
             config: Analysis configuration.
 
@@ -423,6 +816,7 @@ class AnalyseCommand:
         from dev_stats.ci.github_actions_adapter import GithubActionsAdapter
         from dev_stats.ci.gitlab_adapter import GitlabAdapter
         from dev_stats.ci.jenkins_adapter import JenkinsAdapter
+        from dev_stats.ci.sarif_adapter import SarifAdapter
         from dev_stats.ci.teamcity_adapter import TeamCityAdapter
 
         adapters: dict[str, type[AbstractCIAdapter]] = {
@@ -430,6 +824,7 @@ class AnalyseCommand:
             "gitlab": GitlabAdapter,
             "teamcity": TeamCityAdapter,
             "github": GithubActionsAdapter,
+            "sarif": SarifAdapter,
         }
 
         adapter_cls = adapters.get(name)
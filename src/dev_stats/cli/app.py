@@ -9,8 +9,12 @@ import typer
 
 from dev_stats.cli.analyse_command import AnalyseCommand
 from dev_stats.cli.branches_command import BranchesCommand
+from dev_stats.cli.diff_command import DiffCommand
 from dev_stats.cli.gitlog_command import GitlogCommand
+from dev_stats.cli.history_command import HistoryCommand
 from dev_stats.cli.init_hooks_command import InitHooksCommand
+from dev_stats.cli.snapshot_command import SnapshotCommand
+from dev_stats.cli.top_command import TopCommand
 from dev_stats.cli.version_callback import VersionCallback
 
 _version_callback = VersionCallback()
@@ -24,8 +28,18 @@ app = typer.Typer(
 
 _analyse_command = AnalyseCommand()
 _branches_command = BranchesCommand()
+_diff_command = DiffCommand()
 _gitlog_command = GitlogCommand()
+_history_command = HistoryCommand()
 _init_hooks_command = InitHooksCommand()
+_snapshot_command = SnapshotCommand()
+_top_command = TopCommand()
+
+snapshot_app = typer.Typer(
+    name="snapshot",
+    help="Save and load full analysis snapshots.",
+    no_args_is_help=True,
+)
 
 
 @app.callback()
@@ -68,5 +82,11 @@ def _main(
 # type hints via ``typing.get_type_hints()``.
 app.command(name="analyse")(_analyse_command.__call__)
 app.command(name="branches")(_branches_command.__call__)
+app.command(name="diff")(_diff_command.__call__)
 app.command(name="gitlog")(_gitlog_command.__call__)
+app.command(name="history")(_history_command.__call__)
 app.command(name="init-hooks")(_init_hooks_command.__call__)
+app.command(name="top")(_top_command.__call__)
+snapshot_app.command(name="save")(_snapshot_command.save)
+snapshot_app.command(name="load")(_snapshot_command.load)
+app.add_typer(snapshot_app)
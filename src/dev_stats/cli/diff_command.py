@@ -0,0 +1,89 @@
+"""The ``diff`` sub-command."""
+
+from __future__ import annotations
+
+from pathlib import Path
+from typing import TYPE_CHECKING, Annotated
+
+import typer
+from rich.console import Console
+
+from dev_stats.core.report_differ import ReportDiffer
+from dev_stats.core.report_loader import ReportLoader
+
+if TYPE_CHECKING:
+    from dev_stats.core.models import SymbolDelta
+
+
+class DiffCommand:
+    """Compares two saved JSON snapshots and summarises what changed.
+
+    Both inputs are full ``dev-stats.json`` exports (``--format json``).
+    Changed symbols are shown with an arrow per metric: ``↑`` when the
+    value grew, ``↓`` when it shrank.
+    """
+
+    def __call__(
+        self,
+        diff_before: Annotated[
+            Path,
+            typer.Option("--diff-before", help="JSON snapshot of the earlier state."),
+        ],
+        diff_after: Annotated[
+            Path,
+            typer.Option("--diff-after", help="JSON snapshot of the later state."),
+        ],
+    ) -> None:
+        """Compare two analysis snapshots.
+
+        Args:
+            diff_before: Path to the earlier ``dev-stats.json``.
+            diff_after: Path to the later ``dev-stats.json``.
+        """
+        console = Console()
+        loader = ReportLoader()
+        try:
+            before = loader.load(diff_before)
+            after = loader.load(diff_after)
+        except FileNotFoundError as exc:
+            console.print(f"[red]Error:[/red] {exc}")
+            raise typer.Exit(code=1) from exc
+        except (ValueError, KeyError, TypeError) as exc:
+            console.print(f"[red]Error:[/red] could not read snapshot: {exc}")
+            raise typer.Exit(code=1) from exc
+
+        result = ReportDiffer().diff(before, after)
+        console.print(
+            f"[bold]dev-stats diff[/bold] -- {len(result.added)} added, "
+            f"{len(result.removed)} removed, {len(result.changed)} changed"
+        )
+        for delta in result.added:
+            console.print(f"  + {delta.file}::{delta.symbol}", markup=False)
+        for delta in result.removed:
+            console.print(f"  - {delta.file}::{delta.symbol}", markup=False)
+        for delta in result.changed:
+            console.print(
+                f"  ~ {delta.file}::{delta.symbol}  {self._describe(delta)}", markup=False
+            )
+
+    @staticmethod
+    def _describe(delta: SymbolDelta) -> str:
+        """Describe the metric changes of a changed symbol.
+
+        Args:
+            delta: A symbol present in both snapshots.
+
+        Returns:
+            E.g. ``"CC 3 → 5 ↑, LOC 10 → 8 ↓"``; unchanged metrics are omitted.
+        """
+        assert delta.before is not None
+        assert delta.after is not None
+        parts: list[str] = []
+        for label, old, new in (
+            ("CC", delta.before.cyclomatic_complexity, delta.after.cyclomatic_complexity),
+            ("cognitive", delta.before.cognitive_complexity, delta.after.cognitive_complexity),
+            ("LOC", delta.before.lines, delta.after.lines),
+        ):
+            if old != new:
+                parts.append(f"{label} {old} → {new} {'↑' if new > old else '↓'}")
+        return ", ".join(parts)
@@ -0,0 +1,76 @@
+"""The ``history`` sub-command."""
+
+from __future__ import annotations
+
+from pathlib import Path
+from typing import Annotated
+
+import typer
+from rich.console import Console
+from rich.table import Table
+
+from dev_stats.core.git.symbol_history import SymbolHistory
+from dev_stats.core.parser_registry import create_default_registry
+
+
+class HistoryCommand:
+    """Shows how one function's metrics changed over the commit history.
+
+    Past file versions are read from git objects, so the working tree is
+    left alone.
+    """
+
+    def __call__(
+        self,
+        symbol: Annotated[
+            str,
+            typer.Argument(help="Function or method name, e.g. Calculator.Add."),
+        ],
+        repo: Annotated[
+            Path,
+            typer.Argument(help="Path to the Git repository."),
+        ] = Path("."),
+        *,
+        since: Annotated[
+            str | None,
+            typer.Option("--since", help="Only commits since this date."),
+        ] = None,
+        max_commits: Annotated[
+            int,
+            typer.Option("--max-commits", help="Max commits to inspect (0 = all)."),
+        ] = 0,
+    ) -> None:
+        """Print the metrics of *symbol* at each commit that declares it.
+
+        Args:
+            symbol: Qualified symbol name (``Func`` or ``Type.Method``).
+            repo: Path to the repository.
+            since: Date filter for commits.
+            max_commits: Maximum number of commits to inspect.
+        """
+        console = Console()
+        repo_path = repo.resolve()
+        points = SymbolHistory(repo_path, create_default_registry()).history(
+            symbol, since=since, max_commits=max_commits
+        )
+        if not points:
+            console.print(f"[yellow]No commits declare {symbol}.[/yellow]")
+            raise typer.Exit(code=1)
+
+        table = Table(title=f"History of {symbol}")
+        table.add_column("SHA", style="cyan", max_width=8)
+        table.add_column("Date")
+        table.add_column("CC", justify="right")
+        table.add_column("Cognitive", justify="right")
+        table.add_column("LOC", justify="right")
+        table.add_column("File")
+        for point in points:
+            table.add_row(
+                point.sha[:8],
+                point.timestamp.strftime("%Y-%m-%d"),
+                str(point.metrics.cyclomatic_complexity),
+                str(point.metrics.cognitive_complexity),
+                str(point.metrics.lines),
+                point.path,
+            )
+        console.print(table)
@@ -0,0 +1,137 @@
+"""The ``snapshot save`` and ``snapshot load`` sub-commands."""
+
+from __future__ import annotations
+
+from pathlib import Path
+from typing import TYPE_CHECKING, Annotated
+
+import typer
+from rich.console import Console
+from rich.table import Table
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.aggregator import Aggregator
+from dev_stats.core.dispatcher import Dispatcher
+from dev_stats.core.parser_registry import create_default_registry
+from dev_stats.core.scanner import Scanner
+from dev_stats.core.snapshot_store import SnapshotStore, SnapshotVersionError
+
+if TYPE_CHECKING:
+    from dev_stats.core.models import RepoReport
+
+
+class SnapshotCommand:
+    """Saves the code analysis of a repository to a snapshot and reads it back.
+
+    Snapshots keep every field of the report, so later runs (diffs, history)
+    can start from them without re-parsing.  ``load`` detects the binary
+    and JSON encodings by their header.
+    """
+
+    def save(
+        self,
+        output_file: Annotated[
+            Path,
+            typer.Argument(help="Snapshot file to write."),
+        ],
+        repo: Annotated[
+            Path,
+            typer.Argument(help="Path to the repository."),
+        ] = Path("."),
+        *,
+        as_json: Annotated[
+            bool,
+            typer.Option("--json", help="Write readable JSON instead of the binary format."),
+        ] = False,
+    ) -> None:
+        """Analyse *repo* and save the report to *output_file*.
+
+        Args:
+            output_file: Destination snapshot path.
+            repo: Path to the repository.
+            as_json: Use the JSON encoding.
+        """
+        console = Console()
+        repo_path = repo.resolve()
+        try:
+            config = AnalysisConfig.load(repo_path=repo_path)
+        except FileNotFoundError as exc:
+            console.print(f"[red]Error:[/red] {exc}")
+            raise typer.Exit(code=1) from exc
+
+        paths = list(Scanner(repo_path=repo_path, config=config).scan())
+        dispatcher = Dispatcher(registry=create_default_registry(), repo_root=repo_path)
+        files = dispatcher.parse_many(paths, jobs=config.jobs)
+        report = Aggregator().aggregate(files=files, repo_root=repo_path)
+
+        store = SnapshotStore()
+        if as_json:
+            store.save_json(output_file, report)
+        else:
+            store.save(output_file, report)
+        console.print(
+            f"Saved snapshot of {len(report.files)} file(s) to {output_file}", markup=False
+        )
+
+    def load(
+        self,
+        snapshot_file: Annotated[
+            Path,
+            typer.Argument(help="Snapshot file to read."),
+        ],
+        *,
+        show: Annotated[
+            bool,
+            typer.Option("--show", help="Print the per-file metrics."),
+        ] = False,
+    ) -> None:
+        """Load and validate *snapshot_file*.
+
+        Args:
+            snapshot_file: Snapshot written by ``snapshot save``.
+            show: Print a table of the stored files.
+        """
+        console = Console()
+        store = SnapshotStore()
+        try:
+            if store.is_binary(snapshot_file):
+                report = store.load(snapshot_file)
+            else:
+                report = store.load_json(snapshot_file)
+        except (FileNotFoundError, SnapshotVersionError) as exc:
+            console.print(f"[red]Error:[/red] {exc}")
+            raise typer.Exit(code=1) from exc
+        except (ValueError, KeyError, TypeError) as exc:
+            console.print(f"[red]Error:[/red] could not read snapshot: {exc}")
+            raise typer.Exit(code=1) from exc
+
+        console.print(f"Snapshot of {report.root}: {len(report.files)} file(s)", markup=False)
+        if show:
+            console.print(self._files_table(report))
+
+    @staticmethod
+    def _files_table(report: RepoReport) -> Table:
+        """Build the ``--show`` table.
+
+        Args:
+            report: The loaded report.
+
+        Returns:
+            One row per file with lines, functions and maximum CC.
+        """
+        table = Table(title="Snapshot Files")
+        table.add_column("File")
+        table.add_column("Language")
+        table.add_column("Lines", justify="right")
+        table.add_column("Functions", justify="right")
+        table.add_column("Max CC", justify="right")
+        for f in report.files:
+            funcs = f.qualified_functions
+            table.add_row(
+                f.path.as_posix(),
+                f.language,
+                str(f.total_lines),
+                str(len(funcs)),
+                str(max((fn.cyclomatic_complexity for _, fn in funcs), default=0)),
+            )
+        return table
@@ -0,0 +1,81 @@
+"""The ``top`` sub-command."""
+
+from __future__ import annotations
+
+from pathlib import Path
+from typing import Annotated
+
+import typer
+from rich.console import Console
+from rich.table import Table
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.dispatcher import Dispatcher
+from dev_stats.core.metrics.top_n import METRICS, TopNRanker
+from dev_stats.core.parser_registry import create_default_registry
+from dev_stats.core.scanner import Scanner
+
+
+class TopCommand:
+    """Lists the functions of a repository that score highest on one metric."""
+
+    def __call__(
+        self,
+        repo: Annotated[
+            Path,
+            typer.Argument(help="Path to the repository."),
+        ] = Path("."),
+        *,
+        metric: Annotated[
+            str,
+            typer.Option("--metric", "-m", help=f"Metric to rank by: {', '.join(METRICS)}."),
+        ] = "cc",
+        n: Annotated[
+            int,
+            typer.Option("--n", "-n", min=1, help="Number of functions to list."),
+        ] = 10,
+    ) -> None:
+        """Print the *n* highest-ranked functions of *repo*.
+
+        Args:
+            repo: Path to the repository.
+            metric: Metric name, see :data:`METRICS`.
+            n: Number of functions to list.
+        """
+        console = Console()
+        if metric not in METRICS:
+            console.print(
+                f"[red]Error:[/red] unknown metric {metric!r}; "
+                f"expected one of {', '.join(METRICS)}"
+            )
+            raise typer.Exit(code=1)
+        repo_path = repo.resolve()
+        try:
+            config = AnalysisConfig.load(repo_path=repo_path)
+        except FileNotFoundError as exc:
+            console.print(f"[red]Error:[/red] {exc}")
+            raise typer.Exit(code=1) from exc
+
+        paths = list(Scanner(repo_path=repo_path, config=config).scan())
+        dispatcher = Dispatcher(registry=create_default_registry(), repo_root=repo_path)
+        files = dispatcher.parse_many(paths, jobs=config.jobs)
+        ranked = TopNRanker().rank(files, metric, n)
+        if not ranked:
+            console.print("[yellow]No functions found.[/yellow]")
+            return
+
+        table = Table(title=f"Top {len(ranked)} Functions by {metric}")
+        table.add_column("#", justify="right")
+        table.add_column("Symbol", style="cyan")
+        table.add_column("Package")
+        table.add_column("File")
+        table.add_column(metric, justify="right")
+        for position, entry in enumerate(ranked, start=1):
+            table.add_row(
+                str(position),
+                entry.symbol,
+                entry.package or "",
+                entry.file.as_posix(),
+                f"{entry.value:g}",
+            )
+        console.print(table)
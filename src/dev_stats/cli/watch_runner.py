@@ -3,27 +3,31 @@
 from __future__ import annotations
 
 import logging
+import threading
+from pathlib import Path
 from typing import TYPE_CHECKING, Any
 
 if TYPE_CHECKING:
     from collections.abc import Callable
-    from pathlib import Path
 
 logger = logging.getLogger(__name__)
 
-_DEBOUNCE_MS = 500
+_DEBOUNCE_MS = 200
 
 
 class WatchRunner:
     """Watches a directory for file changes and re-runs analysis.
 
-    Uses ``watchfiles`` for efficient filesystem monitoring with a
-    configurable debounce interval.
+    Uses ``watchfiles`` for efficient filesystem monitoring; bursts of
+    events are debounced for 200 ms.  Each batch of changed paths goes to
+    *on_changes* when given (incremental mode), otherwise the full
+    analysis is re-run.
 
     Args:
         repo_path: Directory to watch.
         run_analysis: Callable that performs the analysis.
         extensions: File extensions to monitor.
+        on_changes: Callable receiving the changed paths of each batch.
     """
 
     def __init__(
@@ -31,6 +35,7 @@ class WatchRunner:
         repo_path: Path,
         run_analysis: Callable[[], None],
         extensions: frozenset[str] | None = None,
+        on_changes: Callable[[set[Path]], None] | None = None,
     ) -> None:
         """Initialise the watch runner.
 
@@ -38,9 +43,13 @@ class WatchRunner:
             repo_path: Directory to watch for changes.
             run_analysis: Zero-argument callable that runs the analysis.
             extensions: Optional set of extensions to filter (e.g. {'.py', '.java'}).
+            on_changes: Optional callable receiving the absolute paths of
+                each debounced batch of changes, instead of re-running
+                *run_analysis*.
         """
         self._repo_path = repo_path
         self._run_analysis = run_analysis
+        self._on_changes = on_changes
         self._extensions = extensions or frozenset(
             {
                 ".py",
@@ -70,14 +79,7 @@ class WatchRunner:
         Raises:
             ImportError: If ``watchfiles`` is not installed.
         """
-        try:
-            from watchfiles import watch
-        except ImportError:
-            msg = (
-                "watchfiles is required for --watch mode. "
-                "Install with: pip install dev-stats[watch]"
-            )
-            raise ImportError(msg) from None
+        watch = self._import_watch()
 
         from rich.console import Console
 
@@ -91,17 +93,83 @@ class WatchRunner:
             for changes in watch(
                 self._repo_path,
                 debounce=_DEBOUNCE_MS,
-                step=100,
+                step=50,
                 watch_filter=self._filter,
             ):
-                changed_paths = [str(p) for _, p in changes]
-                logger.debug("Changes detected: %s", changed_paths)
+                if self._on_changes is not None:
+                    self._handle(changes)
+                    continue
+                logger.debug("Changes detected: %s", [str(p) for _, p in changes])
                 console.clear()
                 self._run_analysis()
                 console.print("\n[dim]Watching for changes... (Ctrl-C to stop)[/dim]")
         except KeyboardInterrupt:
             console.print("\n[dim]Watch stopped.[/dim]")
 
+    def start(self) -> Callable[[], None]:
+        """Watch in a background thread without an initial analysis run.
+
+        Returns:
+            A ``stop`` function that ends the watch and joins the thread.
+
+        Raises:
+            ImportError: If ``watchfiles`` is not installed.
+        """
+        watch = self._import_watch()
+        stop_event = threading.Event()
+
+        def loop() -> None:
+            for changes in watch(
+                self._repo_path,
+                debounce=_DEBOUNCE_MS,
+                step=50,
+                watch_filter=self._filter,
+                stop_event=stop_event,
+            ):
+                self._handle(changes)
+
+        thread = threading.Thread(target=loop, name="dev-stats-watch", daemon=True)
+        thread.start()
+
+        def stop() -> None:
+            stop_event.set()
+            thread.join()
+
+        return stop
+
+    def _handle(self, changes: set[tuple[Any, str]]) -> None:
+        """Dispatch one batch of changes.
+
+        Args:
+            changes: ``(change, path)`` pairs from ``watchfiles``.
+        """
+        paths = {Path(p) for _, p in changes}
+        logger.debug("Changes detected: %s", sorted(map(str, paths)))
+        if self._on_changes is not None:
+            self._on_changes(paths)
+        else:
+            self._run_analysis()
+
+    @staticmethod
+    def _import_watch() -> Callable[..., Any]:
+        """Import ``watchfiles.watch``.
+
+        Returns:
+            The ``watch`` generator function.
+
+        Raises:
+            ImportError: If ``watchfiles`` is not installed.
+        """
+        try:
+            from watchfiles import watch
+        except ImportError:
+            msg = (
+                "watchfiles is required for --watch mode. "
+                "Install with: pip install dev-stats[watch]"
+            )
+            raise ImportError(msg) from None
+        return watch
+
     def _filter(self, change: Any, path: str) -> bool:  # noqa: ANN401
         """Filter watch events to only monitored extensions.
 
@@ -112,7 +180,5 @@ class WatchRunner:
         Returns:
             ``True`` if the file has a monitored extension.
         """
-        from pathlib import Path as _Path
-
         _ = change  # unused but required by watchfiles callback signature
-        return _Path(path).suffix in self._extensions
+        return Path(path).suffix in self._extensions
@@ -25,6 +25,11 @@ class AnalysisConfig(BaseSettings):
         repo_path: Path to the Git repository to analyse.
         exclude_patterns: Glob patterns for files/directories to exclude.
         languages: Language filters (empty = all).
+        recursive: Descend into subdirectories when scanning.
+        exclude_tests: Skip test files (``*_test.go``, ``test_*.py``, ...).
+        exclude_generated: Skip files carrying a ``Code generated`` header.
+        jobs: Parallel parse workers (``0`` = one per CPU, ``1`` = serial).
+        cache_dir: Directory for cached parse results (``None`` = no cache).
         thresholds: Quality-gate threshold settings.
         output: Output presentation settings.
         branches: Branch-analysis settings.
@@ -55,6 +60,27 @@ class AnalysisConfig(BaseSettings):
         default=(),
         description="Language filter (empty = all detected languages).",
     )
+    recursive: bool = Field(
+        default=True,
+        description="Scan subdirectories recursively (False = top level only).",
+    )
+    exclude_tests: bool = Field(
+        default=False,
+        description="Skip test files such as *_test.go and test_*.py.",
+    )
+    exclude_generated: bool = Field(
+        default=False,
+        description="Skip generated files (a 'Code generated' header in the first 10 lines).",
+    )
+    jobs: int = Field(
+        default=1,
+        ge=0,
+        description="Parallel parse workers (0 = one per CPU, 1 = serial).",
+    )
+    cache_dir: Path | None = Field(
+        default=None,
+        description="Cache parse results here, keyed by file content hash.",
+    )
     thresholds: ThresholdConfig = Field(default_factory=ThresholdConfig)
     output: OutputConfig = Field(default_factory=OutputConfig)
     branches: BranchConfig = Field(default_factory=BranchConfig)
@@ -69,20 +95,34 @@ class AnalysisConfig(BaseSettings):
         repo_path: Path = Path("."),
         exclude_patterns: tuple[str, ...] | None = None,
         languages: tuple[str, ...] | None = None,
+        recursive: bool | None = None,
+        exclude_tests: bool | None = None,
+        exclude_generated: bool | None = None,
+        jobs: int | None = None,
+        cache_dir: Path | None = None,
     ) -> AnalysisConfig:
         """Build an ``AnalysisConfig`` from TOML + env vars + explicit overrides.
 
         Args:
-            config_path: Optional path to a TOML configuration file.
+            config_path: Optional path to a TOML configuration file.  When
+                omitted, a ``.dev-stats.toml`` or ``.devstats.toml`` found in
+                *repo_path* or a parent up to the module root is used.
             repo_path: Path to the repository to analyse.
             exclude_patterns: Optional glob patterns to exclude.
             languages: Optional language filter.
+            recursive: Optional override for recursive scanning.
+            exclude_tests: Optional override for skipping test files.
+            exclude_generated: Optional override for skipping generated files.
+            jobs: Optional override for the number of parse workers.
+            cache_dir: Optional parse-cache directory.
 
         Returns:
             A fully-resolved, frozen ``AnalysisConfig`` instance.
         """
         base: dict[str, object] = {}
 
+        if config_path is None:
+            config_path = cls._loader.find_config(repo_path)
         if config_path is not None:
             base = cls._loader.load_toml(config_path)
 
@@ -93,5 +133,15 @@ class AnalysisConfig(BaseSettings):
             base["exclude_patterns"] = list(exclude_patterns)
         if languages is not None:
             base["languages"] = list(languages)
+        if recursive is not None:
+            base["recursive"] = recursive
+        if exclude_tests is not None:
+            base["exclude_tests"] = exclude_tests
+        if exclude_generated is not None:
+            base["exclude_generated"] = exclude_generated
+        if jobs is not None:
+            base["jobs"] = jobs
+        if cache_dir is not None:
+            base["cache_dir"] = str(cache_dir)
 
         return cls.model_validate(base)
@@ -13,13 +13,48 @@ if TYPE_CHECKING:
 class ConfigLoader:
     """Loads TOML config files and merges with environment variable overrides.
 
-    Provides three operations:
-    1. ``load_toml`` — read a ``.toml`` file into a dict.
-    2. ``deep_merge`` — recursively merge two dicts (override wins).
-    3. ``apply_env_overrides`` — overlay ``DEV_STATS_*`` env vars onto a dict.
+    Provides four operations:
+    1. ``find_config`` — locate a project-local config file.
+    2. ``load_toml`` — read a ``.toml`` file into a dict.
+    3. ``deep_merge`` — recursively merge two dicts (override wins).
+    4. ``apply_env_overrides`` — overlay ``DEV_STATS_*`` env vars onto a dict.
     """
 
     ENV_PREFIX: str = "DEV_STATS_"
+    CONFIG_FILENAMES: tuple[str, ...] = (".dev-stats.toml", ".devstats.toml")
+    ROOT_MARKERS: tuple[str, ...] = ("go.mod", ".git")
+
+    def find_config(self, start: Path) -> Path | None:
+        """Search *start* and its parents for a project config file.
+
+        Each directory is checked for the names in ``CONFIG_FILENAMES`` in
+        order.  The search stops after the first directory containing one
+        of ``ROOT_MARKERS`` (the module or repository root), so a config
+        file above the project is never picked up.  Without any marker
+        only *start* itself is checked.
+
+        Args:
+            start: Directory (or file) to start searching from.
+
+        Returns:
+            Path to the first config file found, or ``None``.
+        """
+        current = start.resolve()
+        if not current.is_dir():
+            current = current.parent
+        searched: list[Path] = []
+        for directory in (current, *current.parents):
+            searched.append(directory)
+            if any((directory / marker).exists() for marker in self.ROOT_MARKERS):
+                break
+        else:
+            searched = [current]
+        for directory in searched:
+            for name in self.CONFIG_FILENAMES:
+                candidate = directory / name
+                if candidate.is_file():
+                    return candidate
+        return None
 
     def load_toml(self, path: Path) -> dict[str, Any]:
         """Read a TOML file and return its contents as a dict.
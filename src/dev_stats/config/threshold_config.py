@@ -37,11 +37,25 @@ class ThresholdConfig(BaseModel):
         ge=1,
         description="Maximum parameters per function signature.",
     )
+    max_return_values: int = Field(
+        default=3,
+        ge=1,
+        description="Maximum declared return values per function (Go).",
+    )
+    require_default_case: bool = Field(
+        default=False,
+        description="Flag switch statements without a default clause (Go).",
+    )
     max_nesting_depth: int = Field(
         default=4,
         ge=1,
         description="Maximum nesting depth inside a function.",
     )
+    max_file_functions: int = Field(
+        default=40,
+        ge=1,
+        description="Maximum functions plus methods per file.",
+    )
     max_class_methods: int = Field(
         default=20,
         ge=1,
@@ -52,6 +66,11 @@ class ThresholdConfig(BaseModel):
         ge=1,
         description="Maximum lines per class.",
     )
+    max_interface_methods: int = Field(
+        default=10,
+        ge=1,
+        description="Maximum methods declared by an interface (Go).",
+    )
     max_imports: int = Field(
         default=15,
         ge=1,
@@ -3,6 +3,7 @@
 from __future__ import annotations
 
 from collections import defaultdict
+from pathlib import Path
 from typing import TYPE_CHECKING
 
 from dev_stats.core.metrics.coupling_analyser import CouplingAnalyser
@@ -14,7 +15,7 @@ from dev_stats.core.models import (
 )
 
 if TYPE_CHECKING:
-    from pathlib import Path
+    from collections.abc import Sequence
 
     from dev_stats.core.models import (
         BranchesReport,
@@ -27,6 +28,7 @@ if TYPE_CHECKING:
         EnrichedCommit,
         FileBlameReport,
         FileChurn,
+        GoModReport,
         SemverTag,
         StashRecord,
         TagRecord,
@@ -60,6 +62,7 @@ class Aggregator:
         work_patterns: list[WorkPattern] | None = None,
         semver_tags: list[SemverTag] | None = None,
         stashes: list[StashRecord] | None = None,
+        go_module: GoModReport | None = None,
     ) -> RepoReport:
         """Aggregate file reports into a single repository report.
 
@@ -79,6 +82,7 @@ class Aggregator:
             work_patterns: Per-contributor work patterns.
             semver_tags: Parsed semantic-version tags.
             stashes: Stash entries.
+            go_module: Parsed ``go.mod`` of the repository root.
 
         Returns:
             A frozen :class:`RepoReport`.
@@ -106,6 +110,7 @@ class Aggregator:
             work_patterns=tuple(work_patterns) if work_patterns else None,
             semver_tags=tuple(semver_tags) if semver_tags else None,
             stashes=tuple(stashes) if stashes else None,
+            go_module=go_module,
         )
 
     @staticmethod
@@ -139,11 +144,37 @@ class Aggregator:
 
         return tuple(sorted(summaries, key=lambda s: s.file_count, reverse=True))
 
+    @staticmethod
+    def merge_modules(modules: Sequence[ModuleReport], name: str = "(all)") -> ModuleReport:
+        """Lump several module reports into one.
+
+        Args:
+            modules: Module reports to merge.
+            name: Name for the merged module.
+
+        Returns:
+            A :class:`ModuleReport` holding every file, rooted at the common
+            path of the inputs.  ``package`` is kept only if all inputs agree.
+        """
+        files = tuple(f for m in modules for f in m.files)
+        common: list[str] = []
+        for parts in zip(*(m.path.parts for m in modules), strict=False):
+            if len(set(parts)) > 1:
+                break
+            common.append(parts[0])
+        path = Path(*common)
+        packages = {m.package for m in modules}
+        package = packages.pop() if len(packages) == 1 else None
+        return ModuleReport(name=name, path=path, files=files, package=package)
+
     @staticmethod
     def _compute_module_reports(
         files: list[FileReport],
     ) -> tuple[ModuleReport, ...]:
-        """Group files by parent directory into module reports.
+        """Group files by parent directory and package into module reports.
+
+        A directory whose files declare more than one package is split into
+        one module per package, named ``dir [package]``.
 
         Args:
             files: File reports to group.
@@ -151,20 +182,27 @@ class Aggregator:
         Returns:
             Tuple of :class:`ModuleReport` sorted by module name.
         """
-        by_dir: dict[Path, list[FileReport]] = defaultdict(list)
+        by_dir: dict[Path, dict[str | None, list[FileReport]]] = defaultdict(
+            lambda: defaultdict(list)
+        )
         for f in files:
-            parent = f.path.parent
-            by_dir[parent].append(f)
+            by_dir[f.path.parent][f.package].append(f)
 
         modules: list[ModuleReport] = []
-        for dir_path, dir_files in by_dir.items():
-            modules.append(
-                ModuleReport(
-                    name=str(dir_path) if str(dir_path) != "." else "(root)",
-                    path=dir_path,
-                    files=tuple(dir_files),
+        for dir_path, by_package in by_dir.items():
+            dir_name = str(dir_path) if str(dir_path) != "." else "(root)"
+            for package, pkg_files in by_package.items():
+                name = dir_name
+                if len(by_package) > 1:
+                    name = f"{dir_name} [{package or '-'}]"
+                modules.append(
+                    ModuleReport(
+                        name=name,
+                        path=dir_path,
+                        files=tuple(pkg_files),
+                        package=package,
+                    )
                 )
-            )
 
         return tuple(sorted(modules, key=lambda m: m.name))
 
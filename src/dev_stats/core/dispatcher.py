@@ -2,17 +2,47 @@
 
 from __future__ import annotations
 
+import dataclasses
+import functools
 import logging
+import os
+from concurrent.futures import ProcessPoolExecutor
 from typing import TYPE_CHECKING
 
+from dev_stats.core.parse_cache import content_key
+
 if TYPE_CHECKING:
+    from collections.abc import Callable
     from pathlib import Path
 
     from dev_stats.core.models import FileReport
+    from dev_stats.core.parse_cache import AbstractParseCache
     from dev_stats.core.parser_registry import ParserRegistry
 
 logger = logging.getLogger(__name__)
 
+# Exceptions a parser may raise for one bad file; callers log and skip it.
+PARSE_ERRORS = (SyntaxError, OSError, ValueError, UnicodeDecodeError)
+
+
+def _parse_in_worker(dispatcher: Dispatcher, path: Path) -> tuple[FileReport | None, str | None]:
+    """Parse one file inside a worker process.
+
+    Exceptions are returned as text so that one failure does not abort
+    the pool and the parent can log it.
+
+    Args:
+        dispatcher: Dispatcher pickled from the parent process.
+        path: Repository-relative path to the file.
+
+    Returns:
+        ``(report, None)`` on success, ``(None, error)`` on failure.
+    """
+    try:
+        return dispatcher.parse(path), None
+    except PARSE_ERRORS as exc:
+        return None, f"{type(exc).__name__}: {exc}"
+
 
 class Dispatcher:
     """Routes source files to the correct parser via the registry.
@@ -21,19 +51,29 @@ class Dispatcher:
     file does not halt the entire analysis.
     """
 
-    def __init__(self, registry: ParserRegistry, repo_root: Path) -> None:
+    def __init__(
+        self,
+        registry: ParserRegistry,
+        repo_root: Path,
+        cache: AbstractParseCache | None = None,
+    ) -> None:
         """Initialise the dispatcher.
 
         Args:
             registry: Parser registry to look up parsers by extension.
             repo_root: Repository root path (passed to parsers).
+            cache: Optional cache consulted before parsing.
         """
         self._registry = registry
         self._repo_root = repo_root
+        self._cache = cache
 
     def parse(self, path: Path) -> FileReport:
         """Parse a single file and return its report.
 
+        With a cache, the SHA-256 of the file bytes is looked up first and
+        the parser is skipped entirely on a hit.
+
         Args:
             path: Repository-relative path to the file.
 
@@ -42,21 +82,65 @@ class Dispatcher:
         """
         absolute = self._repo_root / path
         parser = self._registry.get_or_default(path)
-        return parser.parse(absolute, self._repo_root)
-
-    def parse_many(self, paths: list[Path]) -> list[FileReport]:
+        if self._cache is None:
+            return parser.parse(absolute, self._repo_root)
+
+        try:
+            key = content_key(absolute.read_bytes(), type(parser).__name__)
+        except OSError:
+            return parser.parse(absolute, self._repo_root)
+        cached = self._cache.get(key)
+        if cached is not None:
+            # Identical content may live at another path.
+            relative = absolute.relative_to(self._repo_root)
+            return cached if cached.path == relative else dataclasses.replace(cached, path=relative)
+        report = parser.parse(absolute, self._repo_root)
+        self._cache.put(key, report)
+        return report
+
+    def parse_many(
+        self,
+        paths: list[Path],
+        *,
+        jobs: int = 1,
+        on_progress: Callable[[], None] | None = None,
+    ) -> list[FileReport]:
         """Parse multiple files, logging and skipping failures.
 
+        With ``jobs > 1`` files are parsed in a process pool; ``jobs=0``
+        uses one worker per CPU.  Every file is attempted and each failure
+        is logged.  Reports are sorted by path, so the result is the same
+        for any *jobs* and input order.
+
         Args:
             paths: Repository-relative paths to parse.
+            jobs: Number of worker processes (``0`` = CPU count).
+            on_progress: Called once per file after it has been handled.
 
         Returns:
             A list of ``FileReport`` objects for successfully parsed files.
         """
+        workers = jobs if jobs > 0 else os.cpu_count() or 1
         reports: list[FileReport] = []
-        for path in paths:
-            try:
-                reports.append(self.parse(path))
-            except (SyntaxError, OSError, ValueError, UnicodeDecodeError):
-                logger.exception("Failed to parse %s", path)
-        return reports
+        if workers == 1 or len(paths) < 2:
+            for path in paths:
+                try:
+                    reports.append(self.parse(path))
+                except PARSE_ERRORS:
+                    logger.exception("Failed to parse %s", path)
+                if on_progress is not None:
+                    on_progress()
+            return sorted(reports, key=lambda r: r.path)
+
+        chunksize = max(1, len(paths) // (workers * 4))
+        with ProcessPoolExecutor(max_workers=workers) as pool:
+            parse = functools.partial(_parse_in_worker, self)
+            results = pool.map(parse, paths, chunksize=chunksize)
+            for path, (report, error) in zip(paths, results, strict=True):
+                if report is not None:
+                    reports.append(report)
+                else:
+                    logger.error("Failed to parse %s: %s", path, error)
+                if on_progress is not None:
+                    on_progress()
+        return sorted(reports, key=lambda r: r.path)
@@ -0,0 +1,89 @@
+"""Query helpers for sorting and filtering individual functions."""
+
+from __future__ import annotations
+
+from fnmatch import fnmatchcase
+from typing import TYPE_CHECKING
+
+if TYPE_CHECKING:
+    from collections.abc import Callable, Iterable
+
+    from dev_stats.core.models import FileReport, FunctionStats
+
+
+class FunctionQuery:
+    """Sorts and filters the functions and methods of one or more files.
+
+    Built from ``[file]`` for a single file or ``report.files`` for a
+    whole repository.  Sorts are stable, so ties keep source order.
+    """
+
+    def __init__(self, files: Iterable[FileReport]) -> None:
+        """Initialise the query.
+
+        Args:
+            files: File reports whose functions are queried.
+        """
+        self._functions = [fs for f in files for fs in f.function_stats]
+
+    @property
+    def functions(self) -> list[FunctionStats]:
+        """Return every function in file and source order."""
+        return list(self._functions)
+
+    def by_complexity(self, ascending: bool = False) -> list[FunctionStats]:
+        """Return the functions ordered by cyclomatic complexity.
+
+        Args:
+            ascending: Lowest complexity first instead of highest.
+
+        Returns:
+            The sorted functions.
+        """
+        return self._sorted(lambda f: f.cyclomatic_complexity, ascending)
+
+    def by_lines(self, ascending: bool = False) -> list[FunctionStats]:
+        """Return the functions ordered by line count.
+
+        Args:
+            ascending: Shortest first instead of longest.
+
+        Returns:
+            The sorted functions.
+        """
+        return self._sorted(lambda f: f.lines, ascending)
+
+    def matching(self, pattern: str) -> list[FunctionStats]:
+        """Return the functions whose qualified name matches a glob.
+
+        Matching is case-sensitive against
+        :attr:`FunctionStats.qualified_name`, e.g. ``calc.Calculator.*``.
+        As with :mod:`fnmatch`, ``*`` also matches dots.
+
+        Args:
+            pattern: Glob with ``*``, ``?`` and ``[...]`` wildcards.
+
+        Returns:
+            Matching functions in source order.
+
+        Raises:
+            ValueError: If *pattern* is empty.
+        """
+        if not pattern:
+            msg = "empty function pattern"
+            raise ValueError(msg)
+        return [f for f in self._functions if fnmatchcase(f.qualified_name, pattern)]
+
+    def _sorted(self, key: Callable[[FunctionStats], int], ascending: bool) -> list[FunctionStats]:
+        """Stable-sort the functions by *key*.
+
+        Args:
+            key: Metric to sort on.
+            ascending: Smallest first instead of largest.
+
+        Returns:
+            The sorted functions.
+        """
+        if ascending:
+            return sorted(self._functions, key=key)
+        return sorted(self._functions, key=lambda f: -key(f))
@@ -0,0 +1,154 @@
+"""Per-symbol metric history built from file contents at past commits."""
+
+from __future__ import annotations
+
+import logging
+import subprocess
+from datetime import datetime
+from pathlib import Path
+from typing import TYPE_CHECKING
+
+from dev_stats.core.dispatcher import PARSE_ERRORS
+from dev_stats.core.models import SymbolHistoryPoint, SymbolMetrics
+
+if TYPE_CHECKING:
+    from dev_stats.core.parser_registry import ParserRegistry
+
+logger = logging.getLogger(__name__)
+
+
+class SymbolHistory:
+    """Tracks how one function's metrics evolve over the commit history.
+
+    For every commit, ``git grep`` finds the files mentioning the symbol's
+    bare name, their contents are read with ``git show`` and analysed
+    in memory, so the working tree is never checked out or modified.
+    """
+
+    def __init__(self, repo_path: Path, registry: ParserRegistry) -> None:
+        """Initialise the history tracker.
+
+        Args:
+            repo_path: Absolute path to the repository root.
+            registry: Parser registry used to analyse past file versions.
+        """
+        self._repo_path = repo_path
+        self._registry = registry
+
+    def history(
+        self,
+        symbol: str,
+        *,
+        since: str | None = None,
+        max_commits: int = 0,
+    ) -> list[SymbolHistoryPoint]:
+        """Return the metrics of *symbol* at each commit that contains it.
+
+        Args:
+            symbol: Qualified name as in :attr:`FileReport.qualified_functions`
+                (``Func`` or ``Type.Method``).
+            since: Date string for ``git log --since``.
+            max_commits: Maximum number of commits to inspect (0 = unlimited).
+
+        Returns:
+            History points, oldest first.  Commits where the symbol does not
+            exist are skipped; the first declaring file wins per commit.
+        """
+        cmd = ["git", "log", "--format=%H %aI"]
+        if max_commits > 0:
+            cmd.append(f"-n{max_commits}")
+        if since:
+            cmd.append(f"--since={since}")
+        try:
+            raw = self._run_git(*cmd)
+        except subprocess.CalledProcessError:
+            logger.debug("Could not read log of %s", self._repo_path)
+            return []
+
+        points: list[SymbolHistoryPoint] = []
+        for line in reversed(raw.splitlines()):
+            sha, _, date = line.partition(" ")
+            if not sha or not date:
+                continue
+            point = self._at_commit(symbol, sha, datetime.fromisoformat(date))
+            if point is not None:
+                points.append(point)
+        return points
+
+    def _at_commit(
+        self,
+        symbol: str,
+        sha: str,
+        timestamp: datetime,
+    ) -> SymbolHistoryPoint | None:
+        """Find and measure *symbol* in the tree of commit *sha*.
+
+        Args:
+            symbol: Qualified symbol name.
+            sha: Commit SHA.
+            timestamp: Author date of the commit.
+
+        Returns:
+            The history point, or ``None`` if no file declares the symbol.
+        """
+        for path in self._candidate_files(symbol.rsplit(".", 1)[-1], sha):
+            try:
+                source = self._run_git("git", "show", f"{sha}:{path}")
+            except subprocess.CalledProcessError:
+                continue
+            parser = self._registry.get_or_default(Path(path))
+            try:
+                report = parser.parse_source(source, Path(path))
+            except PARSE_ERRORS:
+                logger.debug("Failed to parse %s at %s", path, sha)
+                continue
+            for name, func in report.qualified_functions:
+                if name == symbol:
+                    return SymbolHistoryPoint(
+                        sha=sha,
+                        timestamp=timestamp,
+                        path=path,
+                        metrics=SymbolMetrics(
+                            lines=func.lines,
+                            cyclomatic_complexity=func.cyclomatic_complexity,
+                            cognitive_complexity=func.cognitive_complexity,
+                        ),
+                    )
+        return None
+
+    def _candidate_files(self, name: str, sha: str) -> list[str]:
+        """List files in commit *sha* containing the word *name*.
+
+        Args:
+            name: Bare function or method name.
+            sha: Commit SHA.
+
+        Returns:
+            Repository-relative paths, sorted.
+        """
+        try:
+            raw = self._run_git("git", "grep", "-l", "-I", "-w", "-F", name, sha)
+        except subprocess.CalledProcessError:
+            # git grep exits 1 when nothing matches.
+            return []
+        prefix = f"{sha}:"
+        return sorted(line.removeprefix(prefix) for line in raw.splitlines() if line)
+
+    def _run_git(self, *args: str) -> str:
+        """Execute a git command and return stdout.
+
+        Args:
+            *args: Full command arguments.
+
+        Returns:
+            Standard output as a string.
+        """
+        result = subprocess.run(
+            list(args),
+            cwd=self._repo_path,
+            check=True,
+            capture_output=True,
+            text=True,
+            timeout=120,
+        )
+        return result.stdout
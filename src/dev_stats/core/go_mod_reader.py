@@ -0,0 +1,141 @@
+"""Reader for Go ``go.mod`` module files."""
+
+from __future__ import annotations
+
+import re
+from typing import TYPE_CHECKING
+
+from dev_stats.core.models import GoModDependency, GoModReport
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_COMMENT_RE = re.compile(r"//(?P<text>.*)$")
+_QUOTED_RE = re.compile(r'^"(?P<value>[^"]*)"$|^`(?P<raw>[^`]*)`$')
+
+
+class GoModReader:
+    """Parses ``go.mod`` files without invoking the ``go`` tool.
+
+    Understands the ``module``, ``go`` and ``require`` directives, both in
+    single-line and parenthesised block form.  Other directives
+    (``replace``, ``exclude``, ``retract``, ``toolchain``, ...) are skipped.
+    A requirement is indirect when its trailing comment starts with
+    ``indirect``, as written by ``go mod tidy``.
+    """
+
+    def read(self, module_root: Path) -> GoModReport:
+        """Read ``go.mod`` from *module_root*.
+
+        Args:
+            module_root: Directory containing ``go.mod``.
+
+        Returns:
+            The parsed module information.
+
+        Raises:
+            FileNotFoundError: If there is no ``go.mod`` in *module_root*.
+            ValueError: If the file has no ``module`` directive.
+        """
+        path = module_root / "go.mod"
+        return self.parse(path.read_text(encoding="utf-8"), source=str(path))
+
+    def parse(self, text: str, source: str = "go.mod") -> GoModReport:
+        """Parse the contents of a ``go.mod`` file.
+
+        Args:
+            text: File contents.
+            source: Name used in error messages.
+
+        Returns:
+            The parsed module information.
+
+        Raises:
+            ValueError: If there is no ``module`` directive.
+        """
+        module_path = ""
+        go_version = ""
+        dependencies: list[GoModDependency] = []
+        block: str | None = None
+
+        for raw in text.splitlines():
+            code, comment = self._split_comment(raw)
+            if not code:
+                continue
+            if block is not None:
+                if code == ")":
+                    block = None
+                elif block == "require":
+                    dependencies.extend(self._requirement(code.split(), comment))
+                continue
+
+            if code.endswith("("):
+                block = code[:-1].strip()
+                continue
+            verb, *args = code.split(maxsplit=1)
+            rest = args[0] if args else ""
+            if verb == "module":
+                module_path = self._unquote(rest)
+            elif verb == "go":
+                go_version = rest
+            elif verb == "require":
+                dependencies.extend(self._requirement(rest.split(), comment))
+
+        if not module_path:
+            msg = f"{source}: no module directive"
+            raise ValueError(msg)
+        return GoModReport(
+            module_path=module_path,
+            go_version=go_version,
+            dependencies=tuple(dependencies),
+        )
+
+    @staticmethod
+    def _split_comment(line: str) -> tuple[str, str]:
+        """Split a line into code and trailing ``//`` comment.
+
+        Args:
+            line: One line of ``go.mod``.
+
+        Returns:
+            ``(code, comment)``, both stripped.
+        """
+        match = _COMMENT_RE.search(line)
+        if match is None:
+            return line.strip(), ""
+        return line[: match.start()].strip(), match.group("text").strip()
+
+    def _requirement(self, fields: list[str], comment: str) -> list[GoModDependency]:
+        """Build the dependency for one ``require`` line.
+
+        Args:
+            fields: Whitespace-separated module path and version.
+            comment: Trailing comment text.
+
+        Returns:
+            A one-element list, or empty if the line is malformed.
+        """
+        if len(fields) != 2:
+            return []
+        return [
+            GoModDependency(
+                path=self._unquote(fields[0]),
+                version=fields[1],
+                indirect=comment.split(";")[0].strip() == "indirect",
+            )
+        ]
+
+    @staticmethod
+    def _unquote(value: str) -> str:
+        """Strip Go string quotes from *value* if present.
+
+        Args:
+            value: A possibly quoted token.
+
+        Returns:
+            The unquoted value.
+        """
+        match = _QUOTED_RE.match(value)
+        if match is None:
+            return value
+        return match.group("value") if match.group("value") is not None else match.group("raw")
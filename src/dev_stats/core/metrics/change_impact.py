@@ -0,0 +1,131 @@
+"""Change-impact analyser walking the reverse call graph of a function."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from dev_stats.core.models import CallGraph, ImpactReport
+
+if TYPE_CHECKING:
+    from collections.abc import Iterable
+
+    from dev_stats.core.models import FileReport
+
+
+class ChangeImpactAnalyser:
+    """Estimates the blast radius of changing a function.
+
+    Works on the per-file call graphs the Go parsers already build: the
+    graphs of every file in a package are merged, linking calls to
+    functions declared in sibling files, and walked backwards, breadth
+    first, from the changed function.  A package is a directory and
+    package name (:attr:`FileReport.package_key`); calls are never linked
+    across packages and function names are qualified with that key.
+    """
+
+    def __init__(self, max_depth: int | None = None) -> None:
+        """Initialise the analyser.
+
+        Args:
+            max_depth: Furthest caller distance to follow (``1`` = direct
+                callers only), or ``None`` for no limit.
+
+        Raises:
+            ValueError: If *max_depth* is less than 1.
+        """
+        if max_depth is not None and max_depth < 1:
+            msg = f"max_depth must be at least 1, got {max_depth}"
+            raise ValueError(msg)
+        self._max_depth = max_depth
+
+    def analyse(
+        self, files: Iterable[FileReport], symbol: str, package: str | None = None
+    ) -> ImpactReport:
+        """Find the functions that call *symbol*, directly or transitively.
+
+        Args:
+            files: File reports with call graphs; files without one are
+                skipped.
+            symbol: Function name, or ``Type.method`` for a method; it is
+                looked up in every package.  A qualified
+                ``dir [package].name`` picks a single one.
+            package: Only merge files declaring this package, or ``None``
+                to merge every file.
+
+        Returns:
+            The impact report; empty when nothing calls *symbol*.
+        """
+        by_package: dict[str, list[CallGraph]] = {}
+        for f in files:
+            if f.call_graph is not None and (package is None or f.package == package):
+                by_package.setdefault(f.package_key, []).append(f.call_graph)
+        # Unresolved calls link within a package only.
+        graph = self.merge_graphs(
+            _qualify(key, self.merge_graphs(graphs)) for key, graphs in by_package.items()
+        )
+        if symbol in graph.nodes:
+            frontier = [symbol]
+        else:
+            frontier = [name for key in by_package if (name := f"{key}.{symbol}") in graph.nodes]
+
+        seen = set(frontier)
+        levels: list[list[str]] = []
+        while frontier and (self._max_depth is None or len(levels) < self._max_depth):
+            level = sorted(
+                {caller for name in frontier for caller in graph.callers(name)} - seen
+            )
+            if not level:
+                break
+            seen.update(level)
+            levels.append(level)
+            frontier = level
+
+        return ImpactReport(
+            symbol=symbol,
+            direct_callers=tuple(levels[0]) if levels else (),
+            transitive_callers=tuple(name for level in levels[1:] for name in level),
+            max_depth=len(levels),
+        )
+
+    @staticmethod
+    def merge_graphs(graphs: Iterable[CallGraph]) -> CallGraph:
+        """Merge call graphs into one.
+
+        Unresolved calls whose callee is a node of any merged graph become
+        edges; the rest stay unresolved.
+
+        Args:
+            graphs: Per-file call graphs, normally of one package.
+
+        Returns:
+            A graph with the union of their nodes and edges.
+        """
+        nodes: set[str] = set()
+        edges: set[tuple[str, str]] = set()
+        pending: set[tuple[str, str]] = set()
+        for graph in graphs:
+            nodes.update(graph.nodes)
+            edges.update(graph.edges)
+            pending.update(graph.unresolved)
+        edges.update(call for call in pending if call[1] in nodes)
+        return CallGraph(
+            nodes=tuple(sorted(nodes)),
+            edges=tuple(sorted(edges)),
+            unresolved=tuple(sorted(call for call in pending if call[1] not in nodes)),
+        )
+
+
+def _qualify(key: str, graph: CallGraph) -> CallGraph:
+    """Prefix every node of *graph* with *key*, dropping unresolved calls.
+
+    Args:
+        key: Package key, as :attr:`FileReport.package_key`.
+        graph: Merged call graph of that package.
+
+    Returns:
+        The graph with ``key.name`` nodes and edges.
+    """
+    return CallGraph(
+        nodes=tuple(f"{key}.{name}" for name in graph.nodes),
+        edges=tuple((f"{key}.{a}", f"{key}.{b}") for a, b in graph.edges),
+    )
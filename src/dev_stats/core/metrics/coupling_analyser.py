@@ -5,7 +5,7 @@ from __future__ import annotations
 from collections import defaultdict
 from typing import TYPE_CHECKING
 
-from dev_stats.core.models import CouplingReport, ModuleCoupling
+from dev_stats.core.models import CouplingReport, ImportGraph, ModuleCoupling
 
 if TYPE_CHECKING:
     from dev_stats.core.models import FileReport
@@ -27,70 +27,138 @@ class CouplingAnalyser:
         Returns:
             A ``CouplingReport`` with per-module metrics.
         """
-        # Group files by module (parent directory)
-        modules: dict[str, list[FileReport]] = defaultdict(list)
-        for f in files:
-            parent = str(f.path.parent)
-            module_name = parent if parent != "." else "(root)"
-            modules[module_name].append(f)
-
-        # Build import graph: module -> set of modules it depends on
-        module_imports: dict[str, set[str]] = defaultdict(set)
-        # Map top-level import names to module names
-        import_to_module = self._build_import_map(modules)
-
-        for mod_name, mod_files in modules.items():
-            for f in mod_files:
-                for imp in f.imports:
-                    target = import_to_module.get(imp)
-                    if target and target != mod_name:
-                        module_imports[mod_name].add(target)
-
-        # Compute Ce (efferent) and Ca (afferent) for each module
-        efferent: dict[str, int] = {}
-        afferent: dict[str, int] = defaultdict(int)
-
-        for mod_name in modules:
-            deps = module_imports.get(mod_name, set())
-            efferent[mod_name] = len(deps)
-            for dep in deps:
-                afferent[dep] += 1
+        modules = self._group_modules(files)
+        graph = self.build_import_graph(files)
+        coupling = self.package_coupling(graph)
 
         # Compute per-module metrics
         results: list[ModuleCoupling] = []
         for mod_name, mod_files in modules.items():
-            ca = afferent.get(mod_name, 0)
-            ce = efferent.get(mod_name, 0)
-            instability = ce / (ca + ce) if (ca + ce) > 0 else 0.0
-
-            # Abstractness: ratio of abstract classes to total classes
-            total_classes = 0
-            abstract_classes = 0
-            for f in mod_files:
-                for cls in f.classes:
-                    total_classes += 1
-                    # Heuristic: class is abstract if it has "abstract" base
-                    # or "ABC" in base classes, or "Abstract" in name
-                    if any(
-                        "abc" in b.lower() or "abstract" in b.lower() for b in cls.base_classes
-                    ) or cls.name.startswith("Abstract"):
-                        abstract_classes += 1
-
-            abstractness = abstract_classes / total_classes if total_classes > 0 else 0.0
-            distance = abs(abstractness + instability - 1.0)
+            metrics = coupling[mod_name]
+            abstractness = self.abstractness(mod_files)
+            distance = self.distance_from_main_sequence(metrics.instability, abstractness)
 
             results.append(
                 ModuleCoupling(
                     name=mod_name,
-                    afferent=ca,
-                    efferent=ce,
-                    instability=round(instability, 3),
+                    afferent=metrics.afferent,
+                    efferent=metrics.efferent,
+                    instability=round(metrics.instability, 3),
                     abstractness=round(abstractness, 3),
                     distance=round(distance, 3),
                 )
             )
 
-        return CouplingReport(modules=tuple(sorted(results, key=lambda m: m.name)))
+        return CouplingReport(
+            modules=tuple(sorted(results, key=lambda m: m.name)),
+            import_graph=graph,
+        )
+
+    def package_coupling(self, graph: ImportGraph) -> dict[str, ModuleCoupling]:
+        """Compute Ca, Ce and instability for every node of *graph*.
+
+        Abstractness is unknown from the graph alone, so it is left at 0
+        and ``distance`` is ``|I - 1|``; :meth:`analyse` fills both in
+        from the module's types.
+
+        Args:
+            graph: Module-level import graph.
+
+        Returns:
+            ``{module_name: metrics}`` with unrounded values.
+        """
+        result: dict[str, ModuleCoupling] = {}
+        for name in graph.nodes:
+            ca = graph.afferent(name)
+            ce = graph.efferent(name)
+            instability = ce / (ca + ce) if (ca + ce) > 0 else 0.0
+            result[name] = ModuleCoupling(
+                name=name,
+                afferent=ca,
+                efferent=ce,
+                instability=instability,
+                distance=self.distance_from_main_sequence(instability, 0.0),
+            )
+        return result
+
+    @staticmethod
+    def abstractness(files: list[FileReport]) -> float:
+        """Return the share of abstract types among a module's types.
+
+        Go interfaces count as abstract, as do classes deriving from
+        ``ABC``/an ``abstract`` base or named ``Abstract*``.
+
+        Args:
+            files: The module's file reports.
+
+        Returns:
+            Abstract types / all types, or 0.0 without types.
+        """
+        classes = [cls for f in files for cls in f.classes]
+        abstract = sum(
+            1
+            for cls in classes
+            if "interface" in cls.decorators
+            or cls.name.startswith("Abstract")
+            or any("abc" in b.lower() or "abstract" in b.lower() for b in cls.base_classes)
+        )
+        return abstract / len(classes) if classes else 0.0
+
+    @staticmethod
+    def distance_from_main_sequence(instability: float, abstractness: float) -> float:
+        """Return D = |A + I - 1|.
+
+        Args:
+            instability: Instability I.
+            abstractness: Abstractness A.
+
+        Returns:
+            Distance from the main sequence, between 0 and 1.
+        """
+        return abs(abstractness + instability - 1.0)
+
+    def build_import_graph(self, files: list[FileReport]) -> ImportGraph:
+        """Build the module-level import graph.
+
+        Nodes are modules (parent directories); an edge ``a -> b`` means a
+        file in ``a`` imports a name that resolves to ``b``.
+
+        Args:
+            files: File reports containing import lists.
+
+        Returns:
+            An ``ImportGraph`` covering every module.
+        """
+        modules = self._group_modules(files)
+        # Map top-level import names to module names
+        import_to_module = self._build_import_map(modules)
+
+        edges: set[tuple[str, str]] = set()
+        for mod_name, mod_files in modules.items():
+            for f in mod_files:
+                for imp in f.imports:
+                    target = import_to_module.get(imp)
+                    if target and target != mod_name:
+                        edges.add((mod_name, target))
+
+        return ImportGraph(nodes=tuple(sorted(modules)), edges=tuple(sorted(edges)))
+
+    @staticmethod
+    def _group_modules(files: list[FileReport]) -> dict[str, list[FileReport]]:
+        """Group files by module (parent directory).
+
+        Args:
+            files: File reports to group.
+
+        Returns:
+            ``{module_name: files}`` mapping; top-level files go to ``(root)``.
+        """
+        modules: dict[str, list[FileReport]] = defaultdict(list)
+        for f in files:
+            parent = str(f.path.parent)
+            module_name = parent if parent != "." else "(root)"
+            modules[module_name].append(f)
+        return modules
 
     @staticmethod
     def _build_import_map(
@@ -5,7 +5,7 @@ from __future__ import annotations
 import logging
 from typing import TYPE_CHECKING
 
-from dev_stats.core.models import DuplicateBlock, DuplicationReport
+from dev_stats.core.models import DuplicateBlock, DuplicateFunctionGroup, DuplicationReport
 
 if TYPE_CHECKING:
     from dev_stats.core.models import FileReport
@@ -102,6 +102,37 @@ class DuplicationDetector:
             duplication_ratio=ratio,
         )
 
+    def find_duplicate_functions(
+        self,
+        files: list[FileReport],
+    ) -> tuple[DuplicateFunctionGroup, ...]:
+        """Group functions whose bodies are identical up to naming.
+
+        Uses :attr:`MethodReport.body_hash`, so only functions from parsers
+        that compute it (Go) take part.  Functions shorter than
+        ``min_lines`` are skipped so trivial getters do not flood the result.
+
+        Args:
+            files: File reports from the analysis.
+
+        Returns:
+            Groups of two or more functions, ordered by their first member.
+        """
+        by_hash: dict[str, list[str]] = {}
+        for f in files:
+            prefix = f"{f.package_key}." if f.package else f"{f.path.as_posix()}:"
+            for name, func in f.qualified_functions:
+                if func.body_hash is None or func.lines < self._min_lines:
+                    continue
+                by_hash.setdefault(func.body_hash, []).append(prefix + name)
+
+        groups = [
+            DuplicateFunctionGroup(body_hash=digest, functions=tuple(sorted(names)))
+            for digest, names in by_hash.items()
+            if len(names) > 1
+        ]
+        return tuple(sorted(groups, key=lambda g: g.functions))
+
     @staticmethod
     def _normalize(source: str) -> list[str]:
         """Normalize source lines for comparison.
@@ -0,0 +1,186 @@
+"""Report-card grader combining file metrics into a single letter grade."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from dev_stats.core.models import ReportCard
+
+if TYPE_CHECKING:
+    from collections.abc import Sequence
+
+    from dev_stats.core.models import FileReport, MethodReport, RepoReport
+
+AGGREGATE_KEY = "*"
+"""Key of the repository-wide card in :meth:`ReportCardGrader.grade_repo`."""
+
+# (component, weight); the weights sum to 1.
+WEIGHTS: tuple[tuple[str, float], ...] = (
+    ("complexity", 0.35),
+    ("maintainability", 0.30),
+    ("documentation", 0.20),
+    ("size", 0.15),
+)
+
+# (minimum score, grade), best first; anything lower is an F.
+GRADE_BANDS: tuple[tuple[float, str], ...] = (
+    (90.0, "A"),
+    (80.0, "B"),
+    (70.0, "C"),
+    (60.0, "D"),
+)
+
+SIZE_OK_LINES = 400
+SIZE_ZERO_LINES = 1000
+
+
+def _clamp(value: float) -> float:
+    """Clamp *value* to the 0-100 range.
+
+    Args:
+        value: Raw component score.
+
+    Returns:
+        The clamped score.
+    """
+    return max(0.0, min(100.0, value))
+
+
+def grade_for(score: float) -> str:
+    """Map a 0-100 score to its letter grade.
+
+    Args:
+        score: Weighted score.
+
+    Returns:
+        ``"A"`` to ``"D"`` per :data:`GRADE_BANDS`, otherwise ``"F"``.
+    """
+    return next((grade for floor, grade in GRADE_BANDS if score >= floor), "F")
+
+
+class ReportCardGrader:
+    """Grades files and repositories from A to F.
+
+    Each component is first scored on a 0-100 scale:
+
+    * ``complexity``: ``100 - 10 * (avg CC - 1)``, so an average CC of 1
+      scores 100 and 11 or more scores 0.  Files without functions score 100.
+    * ``maintainability``: ``2 * (avg MI - 25)``, so an average MI of 75 or
+      more scores 100 and 25 or less scores 0.
+    * ``documentation``: doc-comment coverage of exported symbols times 100.
+    * ``size``: 100 up to :data:`SIZE_OK_LINES` code lines, falling
+      linearly to 0 at :data:`SIZE_ZERO_LINES`.
+
+    The score is ``sum(weight * component)`` with the weights in
+    :data:`WEIGHTS`.  When no function carries a maintainability index
+    (no Halstead data) that component is dropped and the remaining weights
+    are scaled up to sum to 1.  Each breakdown entry is the weighted
+    contribution, so the entries add up to the score.
+    """
+
+    def grade_file(self, file: FileReport) -> ReportCard:
+        """Grade a single file.
+
+        Args:
+            file: The file report.
+
+        Returns:
+            The file's report card.
+        """
+        docs = file.documentation
+        return self._card(
+            [f for _, f in file.qualified_functions],
+            docs.exported_symbols,
+            docs.documented_symbols,
+            file.code_lines,
+        )
+
+    def grade_repo(self, report: RepoReport) -> dict[str, ReportCard]:
+        """Grade every file plus the repository as a whole.
+
+        The aggregate pools all functions and exported symbols; its size
+        component uses the mean code lines per file.
+
+        Args:
+            report: The analysis report.
+
+        Returns:
+            Cards keyed by POSIX file path, plus the aggregate under
+            :data:`AGGREGATE_KEY`.
+        """
+        cards = {f.path.as_posix(): self.grade_file(f) for f in report.files}
+        functions = [func for f in report.files for _, func in f.qualified_functions]
+        docs = [f.documentation for f in report.files]
+        mean_lines = (
+            sum(f.code_lines for f in report.files) // len(report.files) if report.files else 0
+        )
+        cards[AGGREGATE_KEY] = self._card(
+            functions,
+            sum(d.exported_symbols for d in docs),
+            sum(d.documented_symbols for d in docs),
+            mean_lines,
+        )
+        return cards
+
+    def _card(
+        self,
+        functions: Sequence[MethodReport],
+        exported: int,
+        documented: int,
+        code_lines: int,
+    ) -> ReportCard:
+        """Score the components and build the card.
+
+        Args:
+            functions: Functions and methods being graded.
+            exported: Number of exported symbols.
+            documented: How many of those carry a doc comment.
+            code_lines: Code lines used for the size component.
+
+        Returns:
+            The report card.
+        """
+        components: dict[str, float] = {
+            "complexity": self._complexity_score(functions),
+            "documentation": 100.0 * documented / exported if exported else 100.0,
+            "size": self._size_score(code_lines),
+        }
+        mi_values = [mi for f in functions if (mi := f.maintainability_index) is not None]
+        if mi_values:
+            components["maintainability"] = _clamp(2.0 * (sum(mi_values) / len(mi_values) - 25))
+
+        weights = [(name, weight) for name, weight in WEIGHTS if name in components]
+        total_weight = sum(weight for _, weight in weights)
+        breakdown = tuple(
+            (name, weight / total_weight * components[name]) for name, weight in weights
+        )
+        score = sum(value for _, value in breakdown)
+        return ReportCard(grade=grade_for(score), score=score, breakdown=breakdown)
+
+    @staticmethod
+    def _complexity_score(functions: Sequence[MethodReport]) -> float:
+        """Score the mean cyclomatic complexity.
+
+        Args:
+            functions: Functions and methods being graded.
+
+        Returns:
+            The 0-100 complexity component.
+        """
+        if not functions:
+            return 100.0
+        average = sum(f.cyclomatic_complexity for f in functions) / len(functions)
+        return _clamp(100.0 - 10.0 * (average - 1))
+
+    @staticmethod
+    def _size_score(code_lines: int) -> float:
+        """Score the code-line count.
+
+        Args:
+            code_lines: Code lines.
+
+        Returns:
+            The 0-100 size component.
+        """
+        excess = code_lines - SIZE_OK_LINES
+        return _clamp(100.0 - 100.0 * excess / (SIZE_ZERO_LINES - SIZE_OK_LINES))
@@ -1,21 +1,26 @@
-"""Reader for test coverage data from .coverage (SQLite) and lcov.info files."""
+"""Reader for test coverage data from .coverage (SQLite), lcov.info and Go cover profiles."""
 
 from __future__ import annotations
 
+import dataclasses
 import logging
 import re
 import sqlite3
 from typing import TYPE_CHECKING
 
-from dev_stats.core.models import CoverageReport, FileCoverage
+from dev_stats.core.models import CoverageReport, CoverBlock, CoverProfile, FileCoverage
 
 if TYPE_CHECKING:
     from pathlib import Path
 
+    from dev_stats.core.models import FileReport, MethodReport
+
 logger = logging.getLogger(__name__)
 
 _LCOV_SF_RE = re.compile(r"^SF:(.+)$")
 _LCOV_DA_RE = re.compile(r"^DA:(\d+),(\d+)")
+_COVER_MODE_RE = re.compile(r"^mode:\s*(set|count|atomic)$")
+_COVER_BLOCK_RE = re.compile(r"^(.+):(\d+)\.(\d+),(\d+)\.(\d+) (\d+) (\d+)$")
 
 
 class TestCoverageReader:
@@ -27,6 +32,10 @@ class TestCoverageReader:
 
     Returns ``CoverageReport(overall_ratio=0.0)`` when no coverage file
     is found, without raising exceptions.
+
+    Go profiles written by ``go test -coverprofile`` are read explicitly
+    with :meth:`load_cover_profile` and applied per function with
+    :meth:`annotate_with_coverage`.
     """
 
     def read(self, repo_root: Path) -> CoverageReport:
@@ -66,6 +75,87 @@ class TestCoverageReader:
 
         return CoverageReport()
 
+    @staticmethod
+    def load_cover_profile(path: Path) -> CoverProfile:
+        """Parse a ``go test -coverprofile`` text file.
+
+        Args:
+            path: Profile file, e.g. ``cover.out``.
+
+        Returns:
+            The parsed profile.
+
+        Raises:
+            FileNotFoundError: If *path* does not exist.
+            ValueError: If the ``mode:`` header is missing or a block line
+                is malformed.
+        """
+        lines = path.read_text(encoding="utf-8").splitlines()
+        header = _COVER_MODE_RE.match(lines[0].strip()) if lines else None
+        if header is None:
+            msg = f"{path}: not a Go cover profile (missing 'mode:' line)"
+            raise ValueError(msg)
+        blocks: list[CoverBlock] = []
+        for number, raw in enumerate(lines[1:], start=2):
+            line = raw.strip()
+            # Merged profiles repeat the header once per package.
+            if not line or _COVER_MODE_RE.match(line):
+                continue
+            match = _COVER_BLOCK_RE.match(line)
+            if match is None:
+                msg = f"{path}:{number}: malformed cover profile line {line!r}"
+                raise ValueError(msg)
+            name, *numbers = match.groups()
+            start_line, start_column, end_line, end_column, statements, count = map(int, numbers)
+            blocks.append(
+                CoverBlock(
+                    file=name,
+                    start_line=start_line,
+                    start_column=start_column,
+                    end_line=end_line,
+                    end_column=end_column,
+                    statements=statements,
+                    count=count,
+                )
+            )
+        return CoverProfile(mode=header.group(1), blocks=tuple(blocks))
+
+    @staticmethod
+    def annotate_with_coverage(
+        file: FileReport, profile: CoverProfile, module_path: str = ""
+    ) -> FileReport:
+        """Set ``covered`` on every function of *file* from a Go cover profile.
+
+        A function is covered when any executed block line falls within
+        its ``line``..``end_line`` range.  A file the profile does not
+        mention is returned unchanged, its functions left at ``None``.
+
+        Args:
+            file: Parsed file report, its path relative to the module root.
+            profile: Profile from :meth:`load_cover_profile`.
+            module_path: Module path the profile's import paths start with.
+
+        Returns:
+            A copy of *file* with ``covered`` set on functions and methods.
+        """
+        blocks = profile.blocks_for(file.path, module_path)
+        if not blocks:
+            return file
+        executed = [(b.start_line, b.end_line) for b in blocks if b.count > 0]
+
+        def mark(func: MethodReport) -> MethodReport:
+            covered = any(start <= func.end_line and end >= func.line for start, end in executed)
+            return dataclasses.replace(func, covered=covered)
+
+        return dataclasses.replace(
+            file,
+            functions=tuple(mark(f) for f in file.functions),
+            classes=tuple(
+                dataclasses.replace(c, methods=tuple(mark(m) for m in c.methods))
+                for c in file.classes
+            ),
+        )
+
     def _read_coverage_db(self, db_path: Path) -> CoverageReport:
         """Read a .coverage SQLite database.
 
@@ -0,0 +1,71 @@
+"""Repository-wide ranking of functions by a single metric."""
+
+from __future__ import annotations
+
+import heapq
+from typing import TYPE_CHECKING
+
+from dev_stats.core.models import RankedSymbol
+
+if TYPE_CHECKING:
+    from collections.abc import Callable, Iterable
+
+    from dev_stats.core.models import FileReport, MethodReport
+
+METRICS: dict[str, Callable[[MethodReport], float]] = {
+    "cc": lambda f: float(f.cyclomatic_complexity),
+    "loc": lambda f: float(f.lines),
+    "cognitive": lambda f: float(f.cognitive_complexity),
+    "params": lambda f: float(f.num_parameters),
+    "nesting": lambda f: float(f.nesting_depth),
+}
+"""Metric names accepted by :meth:`TopNRanker.rank`."""
+
+
+class TopNRanker:
+    """Finds the *n* functions scoring highest on one metric.
+
+    A min-heap holding at most *n* entries is kept while walking every
+    function, so ranking ``F`` functions costs ``O(F log n)``.  Ties keep
+    the function seen first, in file and source order.
+    """
+
+    def rank(self, files: Iterable[FileReport], metric: str, n: int) -> list[RankedSymbol]:
+        """Return the top *n* functions of *files* by *metric*.
+
+        Args:
+            files: File reports to rank, e.g. ``report.files``.
+            metric: One of the :data:`METRICS` keys.
+            n: Number of entries to return.
+
+        Returns:
+            Up to *n* symbols, highest value first.
+
+        Raises:
+            ValueError: If *metric* is unknown or *n* is below one.
+        """
+        if metric not in METRICS:
+            msg = f"unknown metric {metric!r}; expected one of {', '.join(METRICS)}"
+            raise ValueError(msg)
+        if n < 1:
+            msg = f"n must be at least 1, got {n}"
+            raise ValueError(msg)
+        value_of = METRICS[metric]
+
+        # Entries are (value, -order, symbol): the heap root is the lowest
+        # value and, among equals, the latest function -- the one to evict.
+        heap: list[tuple[float, int, RankedSymbol]] = []
+        order = 0
+        for f in files:
+            for name, func in f.qualified_functions:
+                entry = (
+                    value_of(func),
+                    -order,
+                    RankedSymbol(package=f.package, file=f.path, symbol=name, value=value_of(func)),
+                )
+                order += 1
+                if len(heap) < n:
+                    heapq.heappush(heap, entry)
+                elif entry[:2] > heap[0][:2]:
+                    heapq.heapreplace(heap, entry)
+        return [symbol for _, _, symbol in sorted(heap, key=lambda e: e[:2], reverse=True)]
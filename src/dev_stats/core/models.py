@@ -7,8 +7,9 @@ hashability and immutability.
 from __future__ import annotations
 
 import enum
+import math
 from dataclasses import dataclass, field
-from typing import TYPE_CHECKING
+from typing import TYPE_CHECKING, TypedDict
 
 if TYPE_CHECKING:
     from datetime import datetime
@@ -72,10 +73,37 @@ class AnomalySeverity(enum.Enum):
     HIGH = "high"
 
 
+class ChannelOpKind(enum.Enum):
+    """Kind of Go channel operation."""
+
+    SEND = "send"
+    RECEIVE = "receive"
+    MAKE = "make"
+    CLOSE = "close"
+
+
+class UsageKind(enum.Enum):
+    """How a Go symbol is referenced at a usage site."""
+
+    CALL = "call"
+    TYPE_REF = "type_ref"
+    COMPOSITE_LITERAL = "composite_literal"
+    FIELD_ACCESS = "field_access"
+
+
 # ---------------------------------------------------------------------------
 # Code-structure dataclasses
 # ---------------------------------------------------------------------------
 
+#: Upper bound (inclusive) and label of each function-length bucket.
+FUNCTION_LENGTH_BUCKETS: tuple[tuple[float, str], ...] = (
+    (10, "1-10"),
+    (25, "11-25"),
+    (50, "26-50"),
+    (100, "51-100"),
+    (math.inf, "101+"),
+)
+
 
 @dataclass(frozen=True)
 class ParameterReport:
@@ -92,6 +120,100 @@ class ParameterReport:
     has_default: bool = False
 
 
+@dataclass(frozen=True)
+class HalsteadReport:
+    """Halstead software-science counts for a single function.
+
+    Derived metrics follow Halstead (1977): vocabulary ``n1 + n2``, length
+    ``N1 + N2``, volume ``length * log2(vocabulary)``, difficulty
+    ``(n1 / 2) * (N2 / n2)`` and effort ``difficulty * volume``.
+
+    Attributes:
+        distinct_operators: Number of distinct operators (``n1``).
+        distinct_operands: Number of distinct operands (``n2``).
+        total_operators: Total operator occurrences (``N1``).
+        total_operands: Total operand occurrences (``N2``).
+    """
+
+    distinct_operators: int = 0
+    distinct_operands: int = 0
+    total_operators: int = 0
+    total_operands: int = 0
+
+    @property
+    def vocabulary(self) -> int:
+        """Return the program vocabulary ``n1 + n2``."""
+        return self.distinct_operators + self.distinct_operands
+
+    @property
+    def length(self) -> int:
+        """Return the program length ``N1 + N2``."""
+        return self.total_operators + self.total_operands
+
+    @property
+    def volume(self) -> float:
+        """Return the volume ``length * log2(vocabulary)``.
+
+        Returns ``0.0`` for an empty vocabulary.
+        """
+        if self.vocabulary == 0:
+            return 0.0
+        return self.length * math.log2(self.vocabulary)
+
+    @property
+    def difficulty(self) -> float:
+        """Return the difficulty ``(n1 / 2) * (N2 / n2)``.
+
+        Returns ``0.0`` when there are no operands.
+        """
+        if self.distinct_operands == 0:
+            return 0.0
+        return (self.distinct_operators / 2) * (self.total_operands / self.distinct_operands)
+
+    @property
+    def effort(self) -> float:
+        """Return the effort ``difficulty * volume``."""
+        return self.difficulty * self.volume
+
+
+def maintainability_index(cc: int, halstead_volume: float, loc: int) -> float:
+    """Return the maintainability index on a 0-100 scale.
+
+    Uses the normalised SEI formula
+    ``max(0, (171 - 5.2 ln V - 0.23 CC - 16.2 ln LOC) * 100 / 171)``.
+    Volumes and line counts below 1 are treated as 1 so the logarithms
+    stay defined.
+
+    Args:
+        cc: Cyclomatic complexity.
+        halstead_volume: Halstead volume ``V``.
+        loc: Lines of code.
+
+    Returns:
+        The index; higher is more maintainable.
+    """
+    volume = max(halstead_volume, 1.0)
+    raw = 171 - 5.2 * math.log(volume) - 0.23 * cc - 16.2 * math.log(max(loc, 1))
+    return max(0.0, raw * 100 / 171)
+
+
+@dataclass(frozen=True)
+class ErrorHandlingReport:
+    """How a Go function produces and handles ``error`` values.
+
+    Attributes:
+        returns_error: The last declared result is of type ``error``.
+        all_errors_checked: Every error assigned from a call is read before
+            it is overwritten or the function ends, and no call result is
+            discarded with ``_`` in the last position.
+        uses_error_wrapping: At least one ``fmt.Errorf`` uses ``%w``.
+    """
+
+    returns_error: bool = False
+    all_errors_checked: bool = True
+    uses_error_wrapping: bool = False
+
+
 @dataclass(frozen=True)
 class MethodReport:
     """Analysis report for a single function or method.
@@ -108,6 +230,13 @@ class MethodReport:
         is_constructor: Whether this is an ``__init__`` method.
         docstring: First line of docstring, or ``None``.
         decorators: Decorator names.
+        halstead: Halstead counts, or ``None`` if not computed.
+        return_count: Number of declared return values (Go).
+        body_hash: SHA-256 of the body with identifiers erased (Go), or
+            ``None``; equal hashes mean structurally identical bodies.
+        error_handling: Error production and handling flags (Go), or ``None``.
+        covered: Whether a test executes the function, or ``None`` without
+            coverage data for the file.
     """
 
     name: str
@@ -121,12 +250,24 @@ class MethodReport:
     is_constructor: bool = False
     docstring: str | None = None
     decorators: tuple[str, ...] = ()
+    halstead: HalsteadReport | None = None
+    return_count: int = 0
+    body_hash: str | None = None
+    error_handling: ErrorHandlingReport | None = None
+    covered: bool | None = None
 
     @property
     def num_parameters(self) -> int:
         """Return the number of parameters."""
         return len(self.parameters)
 
+    @property
+    def maintainability_index(self) -> float | None:
+        """Return the maintainability index, or ``None`` without Halstead data."""
+        if self.halstead is None:
+            return None
+        return maintainability_index(self.cyclomatic_complexity, self.halstead.volume, self.lines)
+
 
 @dataclass(frozen=True)
 class ClassReport:
@@ -142,6 +283,8 @@ class ClassReport:
         base_classes: Base class names.
         docstring: First line of docstring, or ``None``.
         decorators: Decorator names.
+        interface_methods: Method signatures declared by an interface.  Kept
+            apart from ``methods`` because they have no bodies.
     """
 
     name: str
@@ -153,6 +296,7 @@ class ClassReport:
     base_classes: tuple[str, ...] = ()
     docstring: str | None = None
     decorators: tuple[str, ...] = ()
+    interface_methods: tuple[MethodReport, ...] = ()
 
     @property
     def num_methods(self) -> int:
@@ -170,6 +314,382 @@ class ClassReport:
         return sum(1 for m in self.methods if m.is_constructor)
 
 
+@dataclass(frozen=True)
+class CallSite:
+    """A call to a notable built-in (e.g. ``panic``) inside a source file.
+
+    Attributes:
+        name: Called function name.
+        line: 1-based line number of the call.
+        column: 1-based column of the call.
+        function: Enclosing function (``Type.method`` for methods), or ``""``
+            at package level.
+    """
+
+    name: str
+    line: int
+    column: int
+    function: str = ""
+
+
+@dataclass(frozen=True)
+class ChannelOp:
+    """A Go channel operation inside a source file.
+
+    Attributes:
+        kind: Send, receive, ``make(chan ...)`` or ``close(...)``.
+        line: 1-based line number.
+        column: 1-based column.
+        function: Enclosing function (``Type.method`` for methods), or ``""``
+            at package level.
+    """
+
+    kind: ChannelOpKind
+    line: int
+    column: int
+    function: str = ""
+
+
+@dataclass(frozen=True)
+class TypeAssertionSite:
+    """A Go type assertion ``x.(T)``.
+
+    Attributes:
+        type_name: Asserted-to type as written, e.g. ``*os.PathError``.
+        comma_ok: Whether the two-value ``v, ok := x.(T)`` form is used.
+        line: 1-based line number.
+        column: 1-based column of the ``.(``.
+        function: Enclosing function (``Type.method`` for methods), or ``""``
+            at package level.
+    """
+
+    type_name: str
+    comma_ok: bool
+    line: int
+    column: int
+    function: str = ""
+
+
+@dataclass(frozen=True)
+class TypeSwitchSite:
+    """A Go type switch ``switch x.(type) { ... }``.
+
+    Attributes:
+        cases: Number of ``case`` clauses (``default`` is not counted).
+        line: 1-based line number of the ``switch`` keyword.
+        column: 1-based column of the ``switch`` keyword.
+        function: Enclosing function (``Type.method`` for methods), or ``""``
+            at package level.
+    """
+
+    cases: int
+    line: int
+    column: int
+    function: str = ""
+
+
+@dataclass(frozen=True)
+class SwitchSite:
+    """A Go ``switch`` statement, expression or type switch.
+
+    Attributes:
+        cases: Number of ``case`` clauses (``default`` is not counted).
+        line: 1-based line number of the ``switch`` keyword.
+        column: 1-based column of the ``switch`` keyword.
+        function: Enclosing function (``Type.method`` for methods), or ``""``
+            at package level.
+        is_type_switch: Whether this is a ``switch x.(type)``.
+    """
+
+    cases: int
+    line: int
+    column: int
+    function: str = ""
+    is_type_switch: bool = False
+
+
+@dataclass(frozen=True)
+class GlobalVarSite:
+    """A Go package-level ``var`` holding mutable state.
+
+    Attributes:
+        name: Variable name.
+        type_name: Declared type as written, or ``""`` when inferred.
+        line: 1-based line number of the declaration.
+    """
+
+    name: str
+    type_name: str
+    line: int
+
+
+@dataclass(frozen=True)
+class UsageSite:
+    """One reference to a named symbol inside a source file.
+
+    Attributes:
+        name: The symbol searched for.
+        kind: Call, type reference, composite literal or field access.
+        line: 1-based line number.
+        column: 1-based column.
+        function: Enclosing function (``Type.method`` for methods), or ``""``
+            at package level.
+    """
+
+    name: str
+    kind: UsageKind
+    line: int
+    column: int
+    function: str = ""
+
+
+@dataclass(frozen=True)
+class CallGraph:
+    """Directed graph of calls between the functions of one file.
+
+    Attributes:
+        nodes: Qualified function names (``Type.method`` for methods), sorted.
+        edges: ``(caller, callee)`` pairs, sorted.
+        unresolved: ``(caller, callee)`` pairs whose callee is not declared
+            in the file (it may live in a sibling file of the package),
+            sorted.
+    """
+
+    nodes: tuple[str, ...] = ()
+    edges: tuple[tuple[str, str], ...] = ()
+    unresolved: tuple[tuple[str, str], ...] = ()
+
+    def callees(self, name: str) -> list[str]:
+        """Return the functions *name* calls, sorted."""
+        return [dst for src, dst in self.edges if src == name]
+
+    def callers(self, name: str) -> list[str]:
+        """Return the functions that call *name*, sorted."""
+        return [src for src, dst in self.edges if dst == name]
+
+    def strongly_connected_components(self) -> list[list[str]]:
+        """Return the strongly connected components found by Tarjan's algorithm.
+
+        Each component is sorted, and components are sorted by their
+        first member.  Single functions form their own component whether
+        or not they call themselves.
+        """
+        adjacency: dict[str, list[str]] = {n: [] for n in self.nodes}
+        for src, dst in self.edges:
+            adjacency.setdefault(src, []).append(dst)
+            adjacency.setdefault(dst, [])
+
+        index: dict[str, int] = {}
+        lowlink: dict[str, int] = {}
+        stack: list[str] = []
+        on_stack: set[str] = set()
+        components: list[list[str]] = []
+
+        def _connect(node: str) -> None:
+            index[node] = lowlink[node] = len(index)
+            stack.append(node)
+            on_stack.add(node)
+            for nxt in adjacency[node]:
+                if nxt not in index:
+                    _connect(nxt)
+                    lowlink[node] = min(lowlink[node], lowlink[nxt])
+                elif nxt in on_stack:
+                    lowlink[node] = min(lowlink[node], index[nxt])
+            if lowlink[node] == index[node]:
+                component: list[str] = []
+                while True:
+                    member = stack.pop()
+                    on_stack.discard(member)
+                    component.append(member)
+                    if member == node:
+                        break
+                components.append(sorted(component))
+
+        for node in sorted(adjacency):
+            if node not in index:
+                _connect(node)
+        return sorted(components)
+
+
+@dataclass(frozen=True)
+class ReceiverProfile:
+    """Pointer vs. value receiver usage for one Go type.
+
+    Attributes:
+        type_name: Receiver type name.
+        pointer_methods: Methods declared on ``*T``.
+        value_methods: Methods declared on ``T``.
+    """
+
+    type_name: str
+    pointer_methods: tuple[str, ...] = ()
+    value_methods: tuple[str, ...] = ()
+
+    @property
+    def is_mixed(self) -> bool:
+        """Return whether the type mixes pointer and value receivers."""
+        return bool(self.pointer_methods) and bool(self.value_methods)
+
+
+@dataclass(frozen=True)
+class StructStats:
+    """Field and embedding statistics for one Go struct.
+
+    Attributes:
+        name: Struct name.
+        field_count: All fields, named and embedded.
+        embedded_count: Anonymous (embedded) fields.
+        embedding_depth: Longest chain of transitive embedding; ``0`` when
+            nothing is embedded, and a type defined outside the file counts
+            as one level.
+    """
+
+    name: str
+    field_count: int = 0
+    embedded_count: int = 0
+    embedding_depth: int = 0
+
+
+@dataclass(frozen=True)
+class MethodSignature:
+    """Name and arity of one interface method.
+
+    Attributes:
+        name: Method name.
+        param_count: Number of parameters.
+        return_count: Number of declared return values.
+    """
+
+    name: str
+    param_count: int = 0
+    return_count: int = 0
+
+
+@dataclass(frozen=True)
+class InterfaceStats:
+    """Size of one Go interface, for Interface Segregation checks.
+
+    Attributes:
+        name: Interface name.
+        methods: Methods declared in the interface body, in order.
+            Methods of embedded interfaces are not included.
+    """
+
+    name: str
+    methods: tuple[MethodSignature, ...] = ()
+
+    @property
+    def method_count(self) -> int:
+        """Return the number of declared methods."""
+        return len(self.methods)
+
+
+@dataclass(frozen=True)
+class DocumentationStats:
+    """Doc-comment coverage of a file's exported symbols.
+
+    Only types, functions and methods are counted; package-level ``var``
+    and ``const`` declarations are not, even when exported.
+
+    Attributes:
+        exported_symbols: Number of exported types, functions and methods.
+        documented_symbols: How many of those carry a doc comment.
+    """
+
+    exported_symbols: int = 0
+    documented_symbols: int = 0
+
+    @property
+    def coverage_ratio(self) -> float:
+        """Return documented / exported, or ``1.0`` when nothing is exported."""
+        if self.exported_symbols == 0:
+            return 1.0
+        return self.documented_symbols / self.exported_symbols
+
+
+@dataclass(frozen=True)
+class Distribution:
+    """Summary statistics of one per-function metric.
+
+    All fields are zero for a file without functions.
+
+    Attributes:
+        mean: Arithmetic mean.
+        median: Middle value; the mean of the two middle values for an
+            even count.
+        p90: 90th percentile, ``sorted[int(0.9 * n)]``.
+        p99: 99th percentile, ``sorted[int(0.99 * n)]``.
+        std_dev: Population standard deviation.
+        min: Smallest value.
+        max: Largest value.
+    """
+
+    mean: float = 0.0
+    median: float = 0.0
+    p90: float = 0.0
+    p99: float = 0.0
+    std_dev: float = 0.0
+    min: int = 0
+    max: int = 0
+
+
+@dataclass(frozen=True)
+class FunctionStats:
+    """Flat, self-contained record of one function or method.
+
+    Attributes:
+        name: Function/method name.
+        receiver: Receiver type for methods, or ``None`` for functions.
+        package: Declared package name (Go), or ``None``.
+        file: Repository-relative file path.
+        line: Start line number (1-based).
+        end_line: End line number (1-based).
+        param_count: Number of parameters.
+        return_count: Number of declared return values (Go).
+        cyclomatic_complexity: McCabe cyclomatic complexity.
+        cognitive_complexity: Cognitive complexity score.
+        lines: Total line count.
+        covered: Whether a test executes the function, or ``None`` when
+            unknown.
+    """
+
+    name: str
+    receiver: str | None
+    package: str | None
+    file: Path
+    line: int
+    end_line: int
+    param_count: int = 0
+    return_count: int = 0
+    cyclomatic_complexity: int = 1
+    cognitive_complexity: int = 0
+    lines: int = 0
+    covered: bool | None = None
+
+    @property
+    def qualified_name(self) -> str:
+        """Return ``package.Receiver.name``, omitting the parts that are absent."""
+        return ".".join(part for part in (self.package, self.receiver, self.name) if part)
+
+
+class FileExtras(TypedDict, total=False):
+    """Language-specific :class:`FileReport` fields a parser may fill in.
+
+    Every key is a :class:`FileReport` field of the same name and type;
+    omitted keys keep the field default.
+    """
+
+    panic_sites: tuple[CallSite, ...]
+    recover_sites: tuple[CallSite, ...]
+    goroutine_sites: tuple[CallSite, ...]
+    channel_ops: tuple[ChannelOp, ...]
+    type_assertions: tuple[TypeAssertionSite, ...]
+    type_switches: tuple[TypeSwitchSite, ...]
+    global_vars: tuple[GlobalVarSite, ...]
+    missing_default_switches: tuple[SwitchSite, ...]
+    call_graph: CallGraph | None
+
+
 @dataclass(frozen=True)
 class FileReport:
     """Analysis report for a single source file.
@@ -185,6 +705,18 @@ class FileReport:
         classes: Parsed classes.
         functions: Top-level functions (not methods).
         imports: Import statements.
+        package: Declared package name (Go), or ``None``.
+        panic_sites: ``panic(...)`` calls (Go).
+        recover_sites: ``recover()`` calls (Go).
+        goroutine_sites: ``go`` statements (Go); ``name`` is the spawned
+            callee, or ``func`` for a function literal.
+        channel_ops: Channel sends, receives, ``make`` and ``close`` (Go).
+        type_assertions: ``x.(T)`` expressions (Go).
+        type_switches: ``switch x.(type)`` statements (Go).
+        global_vars: Package-level mutable variables (Go).
+        missing_default_switches: ``switch`` statements without a
+            ``default`` clause (Go); ``select`` is not included.
+        call_graph: Calls between this file's functions (Go), or ``None``.
     """
 
     path: Path
@@ -197,6 +729,60 @@ class FileReport:
     classes: tuple[ClassReport, ...] = ()
     functions: tuple[MethodReport, ...] = ()
     imports: tuple[str, ...] = ()
+    package: str | None = None
+    panic_sites: tuple[CallSite, ...] = ()
+    recover_sites: tuple[CallSite, ...] = ()
+    goroutine_sites: tuple[CallSite, ...] = ()
+    channel_ops: tuple[ChannelOp, ...] = ()
+    type_assertions: tuple[TypeAssertionSite, ...] = ()
+    type_switches: tuple[TypeSwitchSite, ...] = ()
+    global_vars: tuple[GlobalVarSite, ...] = ()
+    missing_default_switches: tuple[SwitchSite, ...] = ()
+    call_graph: CallGraph | None = None
+
+    @property
+    def total_panics(self) -> int:
+        """Return the number of ``panic`` call sites."""
+        return len(self.panic_sites)
+
+    @property
+    def total_recovers(self) -> int:
+        """Return the number of ``recover`` call sites."""
+        return len(self.recover_sites)
+
+    @property
+    def total_type_assertions(self) -> int:
+        """Return the number of type assertions (switches excluded)."""
+        return len(self.type_assertions)
+
+    @property
+    def average_type_switch_cases(self) -> float:
+        """Return the mean number of ``case`` clauses per type switch.
+
+        Returns ``0.0`` when the file has no type switches.
+        """
+        if not self.type_switches:
+            return 0.0
+        return sum(s.cases for s in self.type_switches) / len(self.type_switches)
+
+    @property
+    def mutable_global_count(self) -> int:
+        """Return the number of package-level mutable variables."""
+        return len(self.global_vars)
+
+    @property
+    def direct_recursion(self) -> list[str]:
+        """Return sorted names of functions that call themselves."""
+        if self.call_graph is None:
+            return []
+        return sorted(src for src, dst in self.call_graph.edges if src == dst)
+
+    @property
+    def mutual_recursion(self) -> list[list[str]]:
+        """Return groups of two or more functions that call each other in a cycle."""
+        if self.call_graph is None:
+            return []
+        return [c for c in self.call_graph.strongly_connected_components() if len(c) > 1]
 
     @property
     def comment_ratio(self) -> float:
@@ -218,6 +804,456 @@ class FileReport:
         """Return the number of top-level functions."""
         return len(self.functions)
 
+    @property
+    def complexity_map(self) -> dict[str, int]:
+        """Return cyclomatic complexity keyed by qualified name.
+
+        Methods are keyed ``Class.method``; top-level functions by name.
+        """
+        result = {f.name: f.cyclomatic_complexity for f in self.functions}
+        for cls in self.classes:
+            for m in cls.methods:
+                result[f"{cls.name}.{m.name}"] = m.cyclomatic_complexity
+        return result
+
+    @property
+    def cognitive_map(self) -> dict[str, int]:
+        """Return cognitive complexity keyed by qualified name.
+
+        Uses the same keys as :attr:`complexity_map`.
+        """
+        result = {f.name: f.cognitive_complexity for f in self.functions}
+        for cls in self.classes:
+            for m in cls.methods:
+                result[f"{cls.name}.{m.name}"] = m.cognitive_complexity
+        return result
+
+    @property
+    def receiver_profiles(self) -> dict[str, ReceiverProfile]:
+        """Return receiver usage keyed by type name.
+
+        Built from the ``pointer_receiver`` / ``value_receiver`` method
+        decorators set by the Go parsers; classes without tagged methods
+        are omitted.
+        """
+        result: dict[str, ReceiverProfile] = {}
+        for cls in self.classes:
+            pointer = tuple(m.name for m in cls.methods if "pointer_receiver" in m.decorators)
+            value = tuple(m.name for m in cls.methods if "value_receiver" in m.decorators)
+            if pointer or value:
+                result[cls.name] = ReceiverProfile(
+                    type_name=cls.name, pointer_methods=pointer, value_methods=value
+                )
+        return result
+
+    @property
+    def mixed_receiver_types(self) -> list[str]:
+        """Return sorted names of types mixing pointer and value receivers."""
+        return sorted(name for name, p in self.receiver_profiles.items() if p.is_mixed)
+
+    @property
+    def struct_stats(self) -> dict[str, StructStats]:
+        """Return field and embedding statistics per Go struct.
+
+        Embedded types are resolved against the other structs in this
+        file; embedding cycles are cut where they close.
+        """
+        if self.language != "go":
+            return {}
+        structs = {c.name: c for c in self.classes if "interface" not in c.decorators}
+
+        def depth(name: str, visiting: frozenset[str]) -> int:
+            levels = [
+                1 + depth(base, visiting | {base})
+                if base in structs and base not in visiting
+                else 1
+                for base in structs[name].base_classes
+            ]
+            return max(levels, default=0)
+
+        return {
+            name: StructStats(
+                name=name,
+                field_count=cls.num_attributes + len(cls.base_classes),
+                embedded_count=len(cls.base_classes),
+                embedding_depth=depth(name, frozenset({name})),
+            )
+            for name, cls in structs.items()
+        }
+
+    @property
+    def deepest_embedding(self) -> tuple[str, int] | None:
+        """Return ``(struct_name, depth)`` of the deepest embedding chain.
+
+        Ties go to the first struct in source order; ``None`` when no
+        struct embeds another type.
+        """
+        stats = [s for s in self.struct_stats.values() if s.embedding_depth > 0]
+        if not stats:
+            return None
+        deepest = max(stats, key=lambda s: s.embedding_depth)
+        return deepest.name, deepest.embedding_depth
+
+    @property
+    def interfaces(self) -> list[InterfaceStats]:
+        """Return the size of every interface declared in the file."""
+        return [
+            InterfaceStats(
+                name=c.name,
+                methods=tuple(
+                    MethodSignature(
+                        name=m.name, param_count=m.num_parameters, return_count=m.return_count
+                    )
+                    for m in c.interface_methods
+                ),
+            )
+            for c in self.classes
+            if "interface" in c.decorators
+        ]
+
+    def fat_interfaces(self, threshold: int) -> list[str]:
+        """Return names of interfaces declaring more than *threshold* methods.
+
+        Args:
+            threshold: Largest acceptable method count.
+
+        Returns:
+            Names in declaration order.
+        """
+        return [i.name for i in self.interfaces if i.method_count > threshold]
+
+    @property
+    def interface_compliance(self) -> dict[str, list[str]]:
+        """Return the types implementing each interface, see :func:`interface_matrix`."""
+        return interface_matrix(self)
+
+    @property
+    def halstead_map(self) -> dict[str, HalsteadReport]:
+        """Return Halstead counts keyed by qualified name.
+
+        Uses the same keys as :attr:`complexity_map`; functions without
+        Halstead data are omitted.
+        """
+        result = {f.name: f.halstead for f in self.functions if f.halstead is not None}
+        for cls in self.classes:
+            for m in cls.methods:
+                if m.halstead is not None:
+                    result[f"{cls.name}.{m.name}"] = m.halstead
+        return result
+
+    @property
+    def package_key(self) -> str:
+        """Return ``dir [package]`` identifying the file's package.
+
+        Two directories may declare the same package name (``cmd/a`` and
+        ``cmd/b`` both in ``main``), so the directory is part of the key.
+        Top-level files use ``(root)``; a missing package shows as ``-``.
+        """
+        directory = self.path.parent.as_posix()
+        return f"{directory if directory != '.' else '(root)'} [{self.package or '-'}]"
+
+    @property
+    def qualified_functions(self) -> list[tuple[str, MethodReport]]:
+        """Return every function and method with its qualified name.
+
+        Uses the same names as :attr:`complexity_map`.
+        """
+        result = [(f.name, f) for f in self.functions]
+        for cls in self.classes:
+            result.extend((f"{cls.name}.{m.name}", m) for m in cls.methods)
+        return result
+
+    @property
+    def function_stats(self) -> list[FunctionStats]:
+        """Return a :class:`FunctionStats` for every function and method.
+
+        Entries follow :attr:`qualified_functions` order.
+        """
+        result = [self._function_stats(f, None) for f in self.functions]
+        for cls in self.classes:
+            result.extend(self._function_stats(m, cls.name) for m in cls.methods)
+        return result
+
+    def _function_stats(self, func: MethodReport, receiver: str | None) -> FunctionStats:
+        """Flatten *func* into a :class:`FunctionStats` of this file.
+
+        Args:
+            func: The function or method.
+            receiver: Owning type for methods, ``None`` for functions.
+
+        Returns:
+            The flat record.
+        """
+        return FunctionStats(
+            name=func.name,
+            receiver=receiver,
+            package=self.package,
+            file=self.path,
+            line=func.line,
+            end_line=func.end_line,
+            param_count=func.num_parameters,
+            return_count=func.return_count,
+            cyclomatic_complexity=func.cyclomatic_complexity,
+            cognitive_complexity=func.cognitive_complexity,
+            lines=func.lines,
+            covered=func.covered,
+        )
+
+    @property
+    def deepest_nesting(self) -> tuple[str, int] | None:
+        """Return ``(qualified_name, depth)`` of the most deeply nested function.
+
+        Ties go to the first entry of :attr:`qualified_functions`;
+        ``None`` when no function contains a control structure.
+        """
+        nested = [
+            (name, func.nesting_depth)
+            for name, func in self.qualified_functions
+            if func.nesting_depth > 0
+        ]
+        if not nested:
+            return None
+        return max(nested, key=lambda item: item[1])
+
+    def functions_exceeding_params(self, limit: int) -> list[str]:
+        """Return qualified names of functions with more than *limit* parameters.
+
+        Args:
+            limit: Largest acceptable parameter count.
+
+        Returns:
+            Names in :attr:`qualified_functions` order.
+        """
+        return [name for name, f in self.qualified_functions if f.num_parameters > limit]
+
+    def functions_exceeding_returns(self, limit: int) -> list[str]:
+        """Return qualified names of functions with more than *limit* results.
+
+        Args:
+            limit: Largest acceptable return-value count.
+
+        Returns:
+            Names in :attr:`qualified_functions` order.
+        """
+        return [name for name, f in self.qualified_functions if f.return_count > limit]
+
+    @property
+    def unchecked_errors(self) -> list[str]:
+        """Return qualified names of functions that drop or ignore an error.
+
+        See :class:`ErrorHandlingReport` for what counts as checked.
+        """
+        return [
+            name
+            for name, f in self.qualified_functions
+            if f.error_handling is not None and not f.error_handling.all_errors_checked
+        ]
+
+    @property
+    def uncovered_functions(self) -> list[str]:
+        """Return qualified names of functions that coverage data shows as untested.
+
+        Functions without coverage data (``covered is None``) are not listed.
+        """
+        return [name for name, f in self.qualified_functions if f.covered is False]
+
+    @property
+    def length_histogram(self) -> dict[str, int]:
+        """Return function/method counts per line-count bucket.
+
+        Buckets are ``1-10``, ``11-25``, ``26-50``, ``51-100`` and ``101+``;
+        every bucket is present, empty ones with a count of zero.
+        """
+        result = dict.fromkeys([label for _, label in FUNCTION_LENGTH_BUCKETS], 0)
+        for _, func in self.qualified_functions:
+            label = next(lbl for upper, lbl in FUNCTION_LENGTH_BUCKETS if func.lines <= upper)
+            result[label] += 1
+        return result
+
+    @property
+    def average_function_length(self) -> float:
+        """Return the mean line count of functions and methods.
+
+        Returns ``0.0`` when the file has none.
+        """
+        funcs = self.qualified_functions
+        if not funcs:
+            return 0.0
+        return sum(f.lines for _, f in funcs) / len(funcs)
+
+    @property
+    def cc_distribution(self) -> Distribution:
+        """Return the distribution of cyclomatic complexity over all functions."""
+        return distribution([f.cyclomatic_complexity for _, f in self.qualified_functions])
+
+    @property
+    def loc_distribution(self) -> Distribution:
+        """Return the distribution of line counts over all functions."""
+        return distribution([f.lines for _, f in self.qualified_functions])
+
+    @property
+    def average_maintainability_index(self) -> float | None:
+        """Return the mean maintainability index of functions and methods.
+
+        Functions without Halstead data are skipped; ``None`` when no
+        function has an index.
+        """
+        values = [
+            mi for _, f in self.qualified_functions if (mi := f.maintainability_index) is not None
+        ]
+        if not values:
+            return None
+        return sum(values) / len(values)
+
+    @property
+    def longest_function(self) -> tuple[str, int] | None:
+        """Return ``(qualified_name, lines)`` of the longest function.
+
+        Ties go to the first in source order; ``None`` when there are none.
+        """
+        funcs = self.qualified_functions
+        if not funcs:
+            return None
+        name, func = max(funcs, key=lambda item: item[1].lines)
+        return name, func.lines
+
+    @property
+    def exported_symbols(self) -> list[tuple[str, str | None]]:
+        """Return ``(qualified_name, docstring)`` for every exported symbol.
+
+        Go exports uppercase-initial names; other languages export names
+        without a leading underscore.  A method counts only when both it
+        and its type are exported.  Package-level ``var`` and ``const``
+        names are not parsed for doc comments and are left out.
+        """
+        result: list[tuple[str, str | None]] = []
+        for cls in self.classes:
+            if not _is_exported(cls.name, self.language):
+                continue
+            result.append((cls.name, cls.docstring))
+            result.extend(
+                (f"{cls.name}.{m.name}", m.docstring)
+                for m in cls.methods
+                if _is_exported(m.name, self.language)
+            )
+        result.extend(
+            (f.name, f.docstring) for f in self.functions if _is_exported(f.name, self.language)
+        )
+        return result
+
+    @property
+    def documentation(self) -> DocumentationStats:
+        """Return doc-comment coverage of the exported symbols."""
+        exported = self.exported_symbols
+        return DocumentationStats(
+            exported_symbols=len(exported),
+            documented_symbols=sum(1 for _, doc in exported if doc),
+        )
+
+    @property
+    def undocumented_exports(self) -> list[str]:
+        """Return sorted qualified names of exported symbols without docs.
+
+        Covers the same types, functions and methods as
+        :attr:`exported_symbols`; exported ``var`` and ``const`` are not listed.
+        """
+        return sorted(name for name, doc in self.exported_symbols if not doc)
+
+
+def _is_exported(name: str, language: str) -> bool:
+    """Return whether *name* is part of a module's public API.
+
+    Args:
+        name: Unqualified symbol name.
+        language: Lowercase language name.
+
+    Returns:
+        ``True`` for exported names.
+    """
+    if language == "go":
+        return name[:1].isupper()
+    return not name.startswith("_")
+
+
+def distribution(values: list[int]) -> Distribution:
+    """Summarise *values* as a :class:`Distribution`.
+
+    Percentiles index the sorted values at ``int(p * n)`` without
+    interpolation.
+
+    Args:
+        values: One metric value per function.
+
+    Returns:
+        The summary; all zeros when *values* is empty.
+    """
+    if not values:
+        return Distribution()
+    ordered = sorted(values)
+    n = len(ordered)
+    mean = sum(ordered) / n
+    mid = n // 2
+    median = float(ordered[mid]) if n % 2 else (ordered[mid - 1] + ordered[mid]) / 2
+    return Distribution(
+        mean=mean,
+        median=median,
+        p90=float(ordered[int(0.9 * n)]),
+        p99=float(ordered[int(0.99 * n)]),
+        std_dev=math.sqrt(sum((v - mean) ** 2 for v in ordered) / n),
+        min=ordered[0],
+        max=ordered[-1],
+    )
+
+
+def interface_matrix(report: FileReport) -> dict[str, list[str]]:
+    """Return, per interface, the sorted names of types implementing it.
+
+    A type implements an interface when its method set covers every
+    interface method by name and parameter count; full type checking is
+    out of scope.  Embedded interfaces contribute their methods and
+    embedded structs promote theirs, as long as they are declared in the
+    same file.  An interface embedding one declared elsewhere cannot be
+    verified and lists no types.
+
+    Args:
+        report: File whose classes are matched.
+
+    Returns:
+        Mapping of interface name to implementing type names.
+    """
+    interfaces = {c.name: c for c in report.classes if "interface" in c.decorators}
+    types = {c.name: c for c in report.classes if "interface" not in c.decorators}
+
+    def required(name: str, visiting: frozenset[str]) -> set[tuple[str, int]] | None:
+        iface = interfaces[name]
+        sigs = {(m.name, m.num_parameters) for m in iface.interface_methods}
+        for base in iface.base_classes:
+            if base not in interfaces:
+                return None
+            if base not in visiting:
+                inherited = required(base, visiting | {base})
+                if inherited is None:
+                    return None
+                sigs |= inherited
+        return sigs
+
+    def provided(name: str, visiting: frozenset[str]) -> set[tuple[str, int]]:
+        cls = types[name]
+        sigs = {(m.name, m.num_parameters) for m in cls.methods}
+        for base in cls.base_classes:
+            if base in types and base not in visiting:
+                sigs |= provided(base, visiting | {base})
+        return sigs
+
+    method_sets = {name: provided(name, frozenset({name})) for name in types}
+    matrix: dict[str, list[str]] = {}
+    for name in interfaces:
+        sigs = required(name, frozenset({name}))
+        matrix[name] = (
+            [] if sigs is None else sorted(t for t, have in method_sets.items() if sigs <= have)
+        )
+    return matrix
+
 
 # ---------------------------------------------------------------------------
 # Metrics dataclasses
@@ -258,6 +1294,21 @@ class DuplicationReport:
     duplication_ratio: float = 0.0
 
 
+@dataclass(frozen=True)
+class DuplicateFunctionGroup:
+    """Functions whose bodies are identical up to identifier names.
+
+    Attributes:
+        body_hash: The shared :attr:`MethodReport.body_hash`.
+        functions: Sorted qualified names (``dir [package].Type.Method``,
+            see :attr:`FileReport.package_key`, or ``path:Name`` for files
+            without a package).
+    """
+
+    body_hash: str
+    functions: tuple[str, ...]
+
+
 @dataclass(frozen=True)
 class ModuleCoupling:
     """Coupling metrics for a single module.
@@ -279,15 +1330,74 @@ class ModuleCoupling:
     distance: float = 0.0
 
 
+@dataclass(frozen=True)
+class ImportGraph:
+    """Directed module-level import graph.
+
+    Attributes:
+        nodes: Module names, sorted.
+        edges: ``(importer, imported)`` pairs, sorted.
+    """
+
+    nodes: tuple[str, ...] = ()
+    edges: tuple[tuple[str, str], ...] = ()
+
+    def efferent(self, module: str) -> int:
+        """Return the number of modules *module* imports (fan-out)."""
+        return sum(1 for src, _ in self.edges if src == module)
+
+    def afferent(self, module: str) -> int:
+        """Return the number of modules importing *module* (fan-in)."""
+        return sum(1 for _, dst in self.edges if dst == module)
+
+    def cycles(self) -> list[list[str]]:
+        """Return import cycles found by depth-first search.
+
+        Each back edge yields one cycle, listed in import order and rotated
+        to start at its alphabetically smallest module.  Duplicates are
+        dropped; the result is sorted.
+        """
+        adjacency: dict[str, list[str]] = {n: [] for n in self.nodes}
+        for src, dst in self.edges:
+            adjacency.setdefault(src, []).append(dst)
+            adjacency.setdefault(dst, [])
+
+        found: set[tuple[str, ...]] = set()
+        stack: list[str] = []
+        on_stack: set[str] = set()
+        visited: set[str] = set()
+
+        def _visit(node: str) -> None:
+            visited.add(node)
+            stack.append(node)
+            on_stack.add(node)
+            for nxt in adjacency[node]:
+                if nxt in on_stack:
+                    cycle = stack[stack.index(nxt) :]
+                    start = cycle.index(min(cycle))
+                    found.add(tuple(cycle[start:] + cycle[:start]))
+                elif nxt not in visited:
+                    _visit(nxt)
+            stack.pop()
+            on_stack.discard(node)
+
+        for node in sorted(adjacency):
+            if node not in visited:
+                _visit(node)
+        return [list(c) for c in sorted(found)]
+
+
 @dataclass(frozen=True)
 class CouplingReport:
     """Coupling analysis results for the repository.
 
     Attributes:
         modules: Per-module coupling metrics.
+        import_graph: Module-level import graph, or ``None``.
     """
 
     modules: tuple[ModuleCoupling, ...] = ()
+    import_graph: ImportGraph | None = None
 
 
 @dataclass(frozen=True)
@@ -320,6 +1430,59 @@ class CoverageReport:
     overall_ratio: float = 0.0
 
 
+@dataclass(frozen=True)
+class CoverBlock:
+    """One block of a ``go test -coverprofile`` file.
+
+    Attributes:
+        file: Import path of the source file, e.g. ``example.com/calc/calc.go``.
+        start_line: First line of the block (1-based).
+        start_column: Column the block starts at.
+        end_line: Last line of the block.
+        end_column: Column the block ends at.
+        statements: Number of statements in the block.
+        count: Execution count; ``0`` or ``1`` in ``set`` mode.
+    """
+
+    file: str
+    start_line: int
+    start_column: int
+    end_line: int
+    end_column: int
+    statements: int
+    count: int
+
+
+@dataclass(frozen=True)
+class CoverProfile:
+    """A parsed ``go test -coverprofile`` file.
+
+    Attributes:
+        mode: Profile mode: ``set``, ``count`` or ``atomic``.
+        blocks: Blocks in file order.
+    """
+
+    mode: str
+    blocks: tuple[CoverBlock, ...] = ()
+
+    def blocks_for(self, path: Path, module_path: str = "") -> list[CoverBlock]:
+        """Return the blocks of a module-relative source file.
+
+        Profile entries name files by import path, so a block belongs to
+        *path* when its file is exactly *module_path* ``/`` *path*.
+
+        Args:
+            path: File path relative to the module root.
+            module_path: Module path from ``go.mod``, or ``""`` when the
+                profile names files relative to the root.
+
+        Returns:
+            Matching blocks in profile order.
+        """
+        name = f"{module_path}/{path.as_posix()}" if module_path else path.as_posix()
+        return [b for b in self.blocks if b.file == name]
+
+
 @dataclass(frozen=True)
 class FileChurn:
     """Churn score for a single file.
@@ -343,15 +1506,50 @@ class FileChurn:
 class ModuleReport:
     """Aggregated report for a directory (module).
 
+    A directory whose files declare several packages (e.g. Go's ``foo``
+    and ``foo_test``) yields one module per package.
+
     Attributes:
         name: Module/directory name.
         path: Repository-relative path.
         files: File reports within this module.
+        package: Declared package name shared by the files, or ``None``.
     """
 
     name: str
     path: Path
     files: tuple[FileReport, ...] = ()
+    package: str | None = None
+
+    @property
+    def total_lines(self) -> int:
+        """Return total lines across all files."""
+        return sum(f.total_lines for f in self.files)
+
+    @property
+    def code_lines(self) -> int:
+        """Return code lines across all files."""
+        return sum(f.code_lines for f in self.files)
+
+    @property
+    def blank_lines(self) -> int:
+        """Return blank lines across all files."""
+        return sum(f.blank_lines for f in self.files)
+
+    @property
+    def comment_lines(self) -> int:
+        """Return comment lines across all files."""
+        return sum(f.comment_lines for f in self.files)
+
+    @property
+    def num_classes(self) -> int:
+        """Return the number of classes across all files."""
+        return sum(f.num_classes for f in self.files)
+
+    @property
+    def num_functions(self) -> int:
+        """Return the number of top-level functions across all files."""
+        return sum(f.num_functions for f in self.files)
 
 
 @dataclass(frozen=True)
@@ -375,6 +1573,206 @@ class LanguageSummary:
     comment_lines: int
 
 
+@dataclass(frozen=True)
+class GoModDependency:
+    """A ``require`` entry from a ``go.mod`` file.
+
+    Attributes:
+        path: Module path, e.g. ``golang.org/x/mod``.
+        version: Required version, e.g. ``v0.14.0``.
+        indirect: Whether the entry carries a ``// indirect`` comment.
+    """
+
+    path: str
+    version: str
+    indirect: bool = False
+
+
+@dataclass(frozen=True)
+class GoModReport:
+    """Module-level information read from a ``go.mod`` file.
+
+    Attributes:
+        module_path: Path from the ``module`` directive.
+        go_version: Version from the ``go`` directive, or ``""``.
+        dependencies: All ``require`` entries in file order.
+    """
+
+    module_path: str
+    go_version: str = ""
+    dependencies: tuple[GoModDependency, ...] = ()
+
+    @property
+    def direct_dependencies(self) -> tuple[GoModDependency, ...]:
+        """Return the requirements not marked ``// indirect``."""
+        return tuple(d for d in self.dependencies if not d.indirect)
+
+    @property
+    def indirect_dependencies(self) -> tuple[GoModDependency, ...]:
+        """Return the requirements marked ``// indirect``."""
+        return tuple(d for d in self.dependencies if d.indirect)
+
+    @property
+    def num_direct_dependencies(self) -> int:
+        """Return the number of direct requirements."""
+        return len(self.direct_dependencies)
+
+    @property
+    def num_indirect_dependencies(self) -> int:
+        """Return the number of indirect requirements."""
+        return len(self.indirect_dependencies)
+
+
+@dataclass(frozen=True)
+class SymbolMetrics:
+    """Metrics compared between two snapshots for one function or method.
+
+    Attributes:
+        lines: Line count.
+        cyclomatic_complexity: McCabe cyclomatic complexity.
+        cognitive_complexity: Cognitive complexity score.
+    """
+
+    lines: int = 0
+    cyclomatic_complexity: int = 1
+    cognitive_complexity: int = 0
+
+
+@dataclass(frozen=True)
+class SymbolDelta:
+    """One added, removed or changed function/method between snapshots.
+
+    Attributes:
+        file: Repository-relative file path.
+        symbol: Qualified name (``Class.method`` for methods).
+        before: Metrics in the earlier snapshot, ``None`` if added.
+        after: Metrics in the later snapshot, ``None`` if removed.
+    """
+
+    file: str
+    symbol: str
+    before: SymbolMetrics | None = None
+    after: SymbolMetrics | None = None
+
+
+@dataclass(frozen=True)
+class StatsDiff:
+    """Symbol-level difference between two analysis snapshots.
+
+    Attributes:
+        added: Symbols only present in the later snapshot.
+        removed: Symbols only present in the earlier snapshot.
+        changed: Symbols whose LOC, CC or cognitive complexity changed.
+    """
+
+    added: tuple[SymbolDelta, ...] = ()
+    removed: tuple[SymbolDelta, ...] = ()
+    changed: tuple[SymbolDelta, ...] = ()
+
+    @property
+    def is_empty(self) -> bool:
+        """Return whether the snapshots have identical symbols and metrics."""
+        return not (self.added or self.removed or self.changed)
+
+
+@dataclass(frozen=True)
+class FunctionDiff:
+    """One function whose metrics differ between two versions of a file.
+
+    Attributes:
+        name: Qualified name (``Type.method`` for methods).
+        before: The function in the earlier version.
+        after: The function in the later version.
+    """
+
+    name: str
+    before: FunctionStats
+    after: FunctionStats
+
+
+@dataclass(frozen=True)
+class FileDiff:
+    """Function-level difference between two versions of one file.
+
+    Attributes:
+        added: Qualified names only present in the later version, sorted.
+        removed: Qualified names only present in the earlier version, sorted.
+        changed: Functions whose metrics differ, sorted by name.
+    """
+
+    added: tuple[str, ...] = ()
+    removed: tuple[str, ...] = ()
+    changed: tuple[FunctionDiff, ...] = ()
+
+    @property
+    def is_empty(self) -> bool:
+        """Return whether both versions have identical functions and metrics."""
+        return not (self.added or self.removed or self.changed)
+
+
+@dataclass(frozen=True)
+class ImpactReport:
+    """Functions that may be affected by changing one function.
+
+    Attributes:
+        symbol: The function being changed (``Type.method`` for methods).
+        direct_callers: Functions calling *symbol* directly, sorted.  Each
+            is qualified as ``dir [package].name`` (see
+            :attr:`FileReport.package_key`).
+        transitive_callers: Functions reaching *symbol* only through other
+            callers, nearest first and sorted within each distance.
+        max_depth: Longest caller distance found; ``0`` without callers.
+    """
+
+    symbol: str
+    direct_callers: tuple[str, ...] = ()
+    transitive_callers: tuple[str, ...] = ()
+    max_depth: int = 0
+
+    @property
+    def total_impacted(self) -> int:
+        """Return the number of direct and transitive callers."""
+        return len(self.direct_callers) + len(self.transitive_callers)
+
+
+@dataclass(frozen=True)
+class RankedSymbol:
+    """One function in a repository-wide ranking.
+
+    Attributes:
+        package: Declared package name (Go), or ``None``.
+        file: Repository-relative file path.
+        symbol: Qualified name within the file (``Type.method`` for methods).
+        value: The ranked metric's value.
+    """
+
+    package: str | None
+    file: Path
+    symbol: str
+    value: float
+
+
+@dataclass(frozen=True)
+class ReportCard:
+    """Letter grade for a file or a whole repository.
+
+    Attributes:
+        grade: Letter grade, ``"A"`` (best) to ``"F"``.
+        score: Weighted score on a 0-100 scale.
+        breakdown: ``(component, contribution)`` pairs in weighting order;
+            the contributions sum to :attr:`score`.
+    """
+
+    grade: str
+    score: float
+    breakdown: tuple[tuple[str, float], ...] = ()
+
+    @property
+    def contributions(self) -> dict[str, float]:
+        """Return the breakdown as a component-to-contribution mapping."""
+        return dict(self.breakdown)
+
+
 # ---------------------------------------------------------------------------
 # Git dataclasses
 # ---------------------------------------------------------------------------
@@ -571,6 +1969,23 @@ class FileBlameReport:
     lines: tuple[BlameLine, ...] = ()
 
 
+@dataclass(frozen=True)
+class SymbolHistoryPoint:
+    """Metrics of one function or method at one commit.
+
+    Attributes:
+        sha: Full commit SHA.
+        timestamp: Author date of the commit.
+        path: Repository-relative path of the file declaring the symbol.
+        metrics: The symbol's metrics in that commit.
+    """
+
+    sha: str
+    timestamp: datetime
+    path: str
+    metrics: SymbolMetrics
+
+
 # ---------------------------------------------------------------------------
 # Branch / contributor dataclasses
 # ---------------------------------------------------------------------------
@@ -855,6 +2270,7 @@ class RepoReport:
         work_patterns: Per-contributor work patterns.
         semver_tags: Parsed semantic-version tags.
         stashes: Stash entries.
+        go_module: ``go.mod`` information (``--module``), or ``None``.
     """
 
     root: Path
@@ -876,3 +2292,4 @@ class RepoReport:
     work_patterns: tuple[WorkPattern, ...] | None = None
     semver_tags: tuple[SemverTag, ...] | None = None
     stashes: tuple[StashRecord, ...] | None = None
+    go_module: GoModReport | None = None
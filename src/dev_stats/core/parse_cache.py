@@ -0,0 +1,115 @@
+"""On-disk cache of parse results keyed by file content hash."""
+
+from __future__ import annotations
+
+import abc
+import hashlib
+import logging
+import os
+import pickle
+from typing import TYPE_CHECKING
+
+from dev_stats import __version__
+from dev_stats.core.models import FileReport
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+logger = logging.getLogger(__name__)
+
+
+def content_key(data: bytes, parser_name: str) -> str:
+    """Return the cache key for file bytes parsed by *parser_name*.
+
+    The dev-stats version and parser class are mixed into the SHA-256 so
+    that upgrading dev-stats, or routing a file to a different parser,
+    never serves stale results.
+
+    Args:
+        data: Raw file contents.
+        parser_name: Name of the parser class that handles the file.
+
+    Returns:
+        Hex digest.
+    """
+    digest = hashlib.sha256(f"{__version__}\0{parser_name}\0".encode())
+    digest.update(data)
+    return digest.hexdigest()
+
+
+class AbstractParseCache(abc.ABC):
+    """Base class for parse-result caches."""
+
+    @abc.abstractmethod
+    def get(self, key: str) -> FileReport | None:
+        """Return the cached report for *key*, or ``None`` on a miss.
+
+        Args:
+            key: Content key from :func:`content_key`.
+
+        Returns:
+            The cached ``FileReport``, or ``None``.
+        """
+
+    @abc.abstractmethod
+    def put(self, key: str, report: FileReport) -> None:
+        """Store *report* under *key*.
+
+        Args:
+            key: Content key from :func:`content_key`.
+            report: The parse result to cache.
+        """
+
+
+class DiskParseCache(AbstractParseCache):
+    """Stores pickled ``FileReport`` objects as ``<directory>/<key>.pkl``.
+
+    Unreadable or corrupt entries are treated as misses.  Only point the
+    cache at a directory you control: entries are unpickled on read.
+    """
+
+    def __init__(self, directory: Path) -> None:
+        """Initialise the cache, creating *directory* if needed.
+
+        Args:
+            directory: Cache directory.
+        """
+        self._directory = directory
+        directory.mkdir(parents=True, exist_ok=True)
+
+    def get(self, key: str) -> FileReport | None:
+        """Return the cached report for *key*, or ``None`` on a miss.
+
+        Args:
+            key: Content key from :func:`content_key`.
+
+        Returns:
+            The cached ``FileReport``, or ``None``.
+        """
+        path = self._directory / f"{key}.pkl"
+        try:
+            report = pickle.loads(path.read_bytes())
+        except FileNotFoundError:
+            return None
+        except (OSError, pickle.UnpicklingError, EOFError, AttributeError, TypeError):
+            logger.warning("Ignoring unreadable cache entry %s", path)
+            return None
+        return report if isinstance(report, FileReport) else None
+
+    def put(self, key: str, report: FileReport) -> None:
+        """Store *report* under *key*.
+
+        Writes go to a temporary file first so that concurrent workers
+        never observe a partial entry.
+
+        Args:
+            key: Content key from :func:`content_key`.
+            report: The parse result to cache.
+        """
+        path = self._directory / f"{key}.pkl"
+        tmp = path.with_suffix(f".{os.getpid()}.tmp")
+        try:
+            tmp.write_bytes(pickle.dumps(report, protocol=pickle.HIGHEST_PROTOCOL))
+            tmp.replace(path)
+        except OSError:
+            logger.warning("Could not write cache entry %s", path)
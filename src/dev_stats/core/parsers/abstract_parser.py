@@ -10,8 +10,9 @@ from typing import TYPE_CHECKING
 
 if TYPE_CHECKING:
     from pathlib import Path
+    from typing import BinaryIO
 
-    from dev_stats.core.models import ClassReport, FileReport, MethodReport
+    from dev_stats.core.models import ClassReport, FileExtras, FileReport, MethodReport
 
 logger = logging.getLogger(__name__)
 
@@ -35,12 +36,23 @@ class RawLOCCounts:
     blank: int
 
 
-def count_loc(source: str, comment_prefixes: tuple[str, ...] = ("#",)) -> RawLOCCounts:
+def count_loc(
+    source: str,
+    comment_prefixes: tuple[str, ...] = ("#",),
+    block_comment: tuple[str, str] | None = None,
+) -> RawLOCCounts:
     """Count lines of code, comments, and blanks in *source*.
 
+    A line is a comment line when it starts with one of *comment_prefixes*
+    or lies entirely inside a *block_comment*.  Lines holding code followed
+    by a trailing comment count as code, the same convention ``cloc`` and
+    ``gocloc`` use.
+
     Args:
         source: Full file contents as a string.
         comment_prefixes: Tuple of line-comment prefix strings.
+        block_comment: Optional ``(open, close)`` block-comment delimiters,
+            e.g. ``("/*", "*/")``.
 
     Returns:
         A frozen ``RawLOCCounts`` with the tallies.
@@ -49,12 +61,41 @@ def count_loc(source: str, comment_prefixes: tuple[str, ...] = ("#",)) -> RawLOC
     total = len(lines)
     blank = 0
     comment = 0
+    in_block = False
     for line in lines:
         stripped = line.strip()
         if not stripped:
             blank += 1
-        elif any(stripped.startswith(p) for p in comment_prefixes):
+            continue
+        if block_comment is None:
+            if any(stripped.startswith(p) for p in comment_prefixes):
+                comment += 1
+            continue
+
+        opener, closer = block_comment
+        rest = stripped
+        if in_block:
+            end = rest.find(closer)
+            if end == -1:
+                comment += 1
+                continue
+            in_block = False
+            rest = rest[end + len(closer) :].strip()
+        # Consume any further comments; whatever remains is code.
+        while rest.startswith(opener):
+            end = rest.find(closer, len(opener))
+            if end == -1:
+                in_block = True
+                rest = ""
+                break
+            rest = rest[end + len(closer) :].strip()
+        if not rest or any(rest.startswith(p) for p in comment_prefixes):
             comment += 1
+            continue
+        # Code line; a trailing ``/*`` without its closer opens a block.
+        start = rest.rfind(opener)
+        if start != -1 and rest.find(closer, start + len(opener)) == -1:
+            in_block = True
     code = total - blank - comment
     return RawLOCCounts(total=total, code=code, comment=comment, blank=blank)
 
@@ -89,6 +130,26 @@ def detect_encoding(path: Path) -> str:
     return "utf-8"
 
 
+def decode_source(data: bytes) -> str:
+    """Decode raw file bytes the way :meth:`AbstractParser.parse` reads files.
+
+    UTF-8 is tried first with Latin-1 as the fallback (see
+    :func:`detect_encoding`), and ``\r\n`` / ``\r`` line endings are
+    translated to ``\n``.
+
+    Args:
+        data: Raw file contents.
+
+    Returns:
+        The decoded text.
+    """
+    try:
+        text = data.decode("utf-8")
+    except UnicodeDecodeError:
+        text = data.decode("latin-1")
+    return text.replace("\r\n", "\n").replace("\r", "\n")
+
+
 class AbstractParser(abc.ABC):
     """Template-method base class for all language parsers.
 
@@ -119,6 +180,14 @@ class AbstractParser(abc.ABC):
         """
         return ("#",)
 
+    @property
+    def block_comment(self) -> tuple[str, str] | None:
+        """Return ``(open, close)`` block-comment delimiters, if any.
+
+        Defaults to ``None``.  C-family parsers return ``("/*", "*/")``.
+        """
+        return None
+
     # Keep backward-compatible aliases used by ParserRegistry / Dispatcher.
     @property
     def language(self) -> str:
@@ -153,15 +222,14 @@ class AbstractParser(abc.ABC):
         """
         from dev_stats.core.models import FileReport
 
-        encoding = detect_encoding(path)
         try:
-            size_bytes = path.stat().st_size
-        except OSError:
-            size_bytes = 0
-        try:
-            source = path.read_text(encoding=encoding, errors="replace")
+            data = path.read_bytes()
         except OSError:
             logger.warning("Could not read file: %s", path)
+            try:
+                size_bytes = path.stat().st_size
+            except OSError:
+                size_bytes = 0
             return FileReport(
                 path=path.relative_to(repo_root),
                 language=self.language_name,
@@ -172,13 +240,59 @@ class AbstractParser(abc.ABC):
                 size_bytes=size_bytes,
             )
 
-        loc = count_loc(source, self.comment_prefixes)
+        return self.parse_bytes(data, path.relative_to(repo_root))
+
+    def parse_bytes(self, data: bytes, path: Path) -> FileReport:
+        """Analyse raw file bytes as if they were the file at *path*.
+
+        Args:
+            data: File contents, decoded with :func:`decode_source`.
+            path: Path recorded in the report (normally repository-relative).
+
+        Returns:
+            A frozen :class:`FileReport` with ``size_bytes`` of *data*.
+        """
+        return self.parse_source(decode_source(data), path, size_bytes=len(data))
+
+    def parse_stream(self, stream: BinaryIO, path: Path) -> FileReport:
+        """Read a binary stream to the end and analyse it.
+
+        Args:
+            stream: Readable binary file object (pipe, socket, ``BytesIO``).
+            path: Path recorded in the report (normally repository-relative).
+
+        Returns:
+            A frozen :class:`FileReport`.
+        """
+        return self.parse_bytes(stream.read(), path)
+
+    def parse_source(self, source: str, path: Path, size_bytes: int | None = None) -> FileReport:
+        """Analyse in-memory *source* as if it were the file at *path*.
+
+        Nothing is read from disk, so this also works for content taken
+        from git objects or editor buffers.
+
+        Args:
+            source: Full file contents.
+            path: Path recorded in the report (normally repository-relative).
+            size_bytes: Size to record; defaults to the UTF-8 length of *source*.
+
+        Returns:
+            A frozen :class:`FileReport`.
+        """
+        from dev_stats.core.models import FileReport
+
+        if size_bytes is None:
+            size_bytes = len(source.encode("utf-8"))
+        loc = count_loc(source, self.comment_prefixes, self.block_comment)
         classes = self._extract_classes(source, path)
         functions = self._extract_functions(source, path)
         imports = self._detect_imports(source)
+        package = self._detect_package(source)
+        extras = self._extract_extras(source)
 
         return FileReport(
-            path=path.relative_to(repo_root),
+            path=path,
             language=self.language_name,
             total_lines=loc.total,
             code_lines=loc.code,
@@ -188,8 +302,37 @@ class AbstractParser(abc.ABC):
             classes=tuple(classes),
             functions=tuple(functions),
             imports=tuple(imports),
+            package=package,
+            **extras,
         )
 
+    def _extract_extras(self, source: str) -> FileExtras:
+        """Return additional :class:`FileReport` fields for *source*.
+
+        Defaults to no fields.  Override to populate language-specific
+        fields such as ``panic_sites``.
+
+        Args:
+            source: Full file contents.
+
+        Returns:
+            Keyword arguments merged into the ``FileReport``.
+        """
+        return {}
+
+    def _detect_package(self, source: str) -> str | None:
+        """Detect the declared package name in *source*.
+
+        Defaults to ``None``.  Override for languages with a package clause.
+
+        Args:
+            source: Full file contents.
+
+        Returns:
+            The package name, or ``None`` if not declared.
+        """
+        return None
+
     @abc.abstractmethod
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
         """Extract class definitions from *source*.
@@ -156,6 +156,11 @@ class CppParser(AbstractParser):
         """Return ``('//',)``."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')``."""
+        return ("/*", "*/")
+
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
         """Extract class and struct definitions.
 
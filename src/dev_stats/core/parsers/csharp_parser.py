@@ -165,6 +165,11 @@ class CSharpParser(AbstractParser):
         """Return ``('//',)``."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')``."""
+        return ("/*", "*/")
+
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
         """Extract class, interface, struct, and enum definitions.
 
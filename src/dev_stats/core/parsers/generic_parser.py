@@ -112,20 +112,17 @@ class GenericParser(AbstractParser):
             A :class:`FileReport` with line counts and detected language.
         """
         from dev_stats.core.models import FileReport
-        from dev_stats.core.parsers.abstract_parser import RawLOCCounts, count_loc, detect_encoding
 
-        lang, prefixes = self._lookup(path)
-        encoding = detect_encoding(path)
-        try:
-            size_bytes = path.stat().st_size
-        except OSError:
-            size_bytes = 0
         try:
-            source = path.read_text(encoding=encoding, errors="replace")
+            data = path.read_bytes()
         except OSError:
+            try:
+                size_bytes = path.stat().st_size
+            except OSError:
+                size_bytes = 0
             return FileReport(
                 path=path.relative_to(repo_root),
-                language=lang,
+                language=self._lookup(path)[0],
                 total_lines=0,
                 code_lines=0,
                 blank_lines=0,
@@ -133,9 +130,28 @@ class GenericParser(AbstractParser):
                 size_bytes=size_bytes,
             )
 
+        return self.parse_bytes(data, path.relative_to(repo_root))
+
+    def parse_source(self, source: str, path: Path, size_bytes: int | None = None) -> FileReport:
+        """Count lines of in-memory *source* using the language of *path*.
+
+        Args:
+            source: Full file contents.
+            path: Path recorded in the report; its extension picks the language.
+            size_bytes: Size to record; defaults to the UTF-8 length of *source*.
+
+        Returns:
+            A :class:`FileReport` with line counts and detected language.
+        """
+        from dev_stats.core.models import FileReport
+        from dev_stats.core.parsers.abstract_parser import RawLOCCounts, count_loc
+
+        lang, prefixes = self._lookup(path)
+        if size_bytes is None:
+            size_bytes = len(source.encode("utf-8"))
         loc: RawLOCCounts = count_loc(source, prefixes)
         return FileReport(
-            path=path.relative_to(repo_root),
+            path=path,
             language=lang,
             total_lines=loc.total,
             code_lines=loc.code,
@@ -2,43 +2,122 @@
 
 from __future__ import annotations
 
+import hashlib
 import logging
 import re
 from typing import TYPE_CHECKING
 
-from dev_stats.core.models import ClassReport, MethodReport, ParameterReport
+from dev_stats.core.models import (
+    CallGraph,
+    CallSite,
+    ChannelOp,
+    ChannelOpKind,
+    ClassReport,
+    ErrorHandlingReport,
+    GlobalVarSite,
+    HalsteadReport,
+    MethodReport,
+    ParameterReport,
+    SwitchSite,
+    TypeAssertionSite,
+    TypeSwitchSite,
+    UsageKind,
+    UsageSite,
+)
 from dev_stats.core.parsers.abstract_parser import AbstractParser
 
 if TYPE_CHECKING:
     from pathlib import Path
 
+    from dev_stats.core.models import FileExtras
+
 logger = logging.getLogger(__name__)
 
+# ── Package clause ──────────────────────────────────────────────────────
+_PACKAGE_RE = re.compile(
+    r"^\s*package\s+(?P<name>\w+)",
+    re.MULTILINE,
+)
+
 # ── Struct detection ────────────────────────────────────────────────────
 _STRUCT_RE = re.compile(
-    r"^\s*type\s+(?P<name>\w+)\s+struct\s*\{",
+    r"^[ \t]*type\s+(?P<name>\w+)\s+struct\s*\{",
     re.MULTILINE,
 )
 
 # ── Interface detection ─────────────────────────────────────────────────
 _INTERFACE_RE = re.compile(
-    r"^\s*type\s+(?P<name>\w+)\s+interface\s*\{",
+    r"^[ \t]*type\s+(?P<name>\w+)\s+interface\s*\{",
     re.MULTILINE,
 )
 
+# ── ``interface{}`` / ``struct{}`` type literals in signatures ─────────
+_TYPE_LITERAL_RE = re.compile(r"\b(?:interface|struct)\s*$")
+# Type before a composite literal brace in a control header: ``[]string{``,
+# ``map[K]*V{`` or ``struct{``.
+_COMPOSITE_TYPE_RE = re.compile(r"(?:\b(?:interface|struct)|\]\s*\*?\s*[A-Za-z_][\w.]*)\s*$")
+
+# ── Struct fields (one declaration per line or ``;``) ─────────────────
+_FIELD_NAMES_RE = re.compile(r"^(?P<names>\w+(?:\s*,\s*\w+)*)\s+\S")
+_EMBEDDED_RE = re.compile(r"^\*?(?P<type>[\w.]+)(?:\[[^\]]*\])?$")
+
+# ── Interface method specs (``Name(params) results``) ──────────────────
+_METHOD_SPEC_RE = re.compile(r"^(?P<name>[A-Za-z_]\w*)\s*\((?P<params>[^)]*)\)")
+
 # ── Top-level function detection ────────────────────────────────────────
 _FUNC_RE = re.compile(
-    r"^\s*func\s+(?P<name>\w+)\s*\((?P<params>[^)]*)\)",
+    r"^[ \t]*func\s+(?P<name>\w+)\s*\((?P<params>[^)]*)\)",
     re.MULTILINE,
 )
 
 # ── Method detection (func with receiver) ───────────────────────────────
 _METHOD_RE = re.compile(
-    r"^\s*func\s+\(\s*(?P<recv>\w+)\s+\*?(?P<type>\w+)\s*\)\s+"
+    r"^[ \t]*func\s+\(\s*(?P<recv>\w+)\s+(?P<ptr>\*)?(?P<type>\w+)\s*\)\s+"
     r"(?P<name>\w+)\s*\((?P<params>[^)]*)\)",
     re.MULTILINE,
 )
 
+# ── Any func declaration, with optional receiver type ────────────────────
+_DECL_RE = re.compile(
+    r"^[ \t]*func\s+(?:\(\s*(?P<recv>\w+)\s+\*?(?P<type>\w+)\s*\)\s*)?(?P<name>\w+)",
+    re.MULTILINE,
+)
+
+# ── Calls ``name(`` and ``recv.name(`` ───────────────────────────────
+_CALL_RE = re.compile(r"(?<![\w.])(?:(?P<recv>\w+)\s*\.\s*)?(?P<name>\w+)\s*\(")
+
+# ── Symbol usages: declared names and composite-literal keys ────────────
+# Text before a declared name: ``type X``, ``func X``, ``func (r T) X``.
+_DECLARED_NAME_RE = re.compile(r"^[ \t]*(?:type|func(?:\s*\([^)]*\))?)\s+$")
+_LITERAL_KEY_RE = re.compile(r":(?!=)")
+
+# ── Concurrency ─────────────────────────────────────────────────────────
+_GO_STMT_RE = re.compile(r"(?<![\w.])go\s+(?P<target>func\b|[A-Za-z_][\w.]*)")
+_CHAN_OP_RE = re.compile(
+    r"(?P<make>(?<![\w.])make\s*\(\s*(?:<-\s*)?chan\b)"
+    r"|(?P<close>(?<![\w.])close\s*\()"
+    r"|<-",
+)
+_CHAN_TYPE_RE = re.compile(r"chan\b")
+_TRAILING_WORD_RE = re.compile(r"\w+$")
+
+# ── Type assertions and type switches ────────────────────────────────
+_TYPE_ASSERT_RE = re.compile(r"\.\(\s*(?P<type>(?:[^()]|\([^()]*\))+?)\s*\)")
+_COMMA_OK_RE = re.compile(r"\w+\s*,\s*\w+\s*:?=\s*[^=,;(){}]*$")
+_CASE_OR_BRACE_RE = re.compile(r"[{}]|\bcase\b")
+_SWITCH_RE = re.compile(r"(?<![\w.])switch\b")
+_CLAUSE_OR_BRACE_RE = re.compile(r"[{}]|\b(?:case|default)\b")
+_TYPE_GUARD_RE = re.compile(r"\.\(\s*type\s*\)")
+
+# ── Package-level ``var`` declarations ──────────────────────────────
+_VAR_RE = re.compile(r"^[ \t]*var\b[ \t]*(?P<block>\()?", re.MULTILINE)
+_VAR_SPEC_RE = re.compile(
+    r"^(?P<names>\w+(?:\s*,\s*\w+)*)\s*(?P<type>[^=]*?)\s*(?:=\s*(?P<value>.*))?$"
+)
+_COMPOSITE_LITERAL_RE = re.compile(r"^&?(?P<type>[\w.]+)\s*\{")
+# Synchronisation primitives that are global by design.
+_INTENTIONAL_GLOBALS = frozenset({"sync.Once", "sync.Mutex", "sync.RWMutex"})
+
 # ── Import detection ────────────────────────────────────────────────────
 _IMPORT_SINGLE_RE = re.compile(
     r'^\s*import\s+"(?P<pkg>[^"]+)"',
@@ -53,27 +132,317 @@ _IMPORT_LINE_RE = re.compile(
 )
 
 # ── CC branch tokens ───────────────────────────────────────────────────
+# ``range`` is not listed: it only ever appears in a ``for`` header, which
+# already counts as the branch point.
 _CC_PATTERN = re.compile(
     r"\b(?:if|else\s+if|for|case|select)\b"
     r"|&&|\|\|",
 )
 
+# ── Comments and literals (masked before counting) ─────────────────────
+_NOISE_RE = re.compile(
+    r"//[^\n]*"
+    r"|/\*.*?\*/"
+    r'|"(?:\\.|[^"\\\n])*"'
+    r"|`[^`]*`"
+    r"|'(?:\\.|[^'\\\n])*'",
+    re.DOTALL,
+)
+
+
+# ── Cognitive complexity tokens ────────────────────────────────────────
+_COGNITIVE_TOKEN_RE = re.compile(
+    r"\belse\s+if\b"
+    r"|\b(?:break|continue)[ \t]+\w+"
+    r"|\b(?:if|else|for|switch|select|func|goto)\b"
+    r"|&&|\|\||[{};\n]",
+)
+_NESTING_KEYWORDS = frozenset({"if", "else if", "else", "for", "switch", "select", "func"})
+_CONTROL_KEYWORDS = _NESTING_KEYWORDS - {"func"}
+
+
+# ── Halstead tokens ─────────────────────────────────────────────────────
+# Operators: arithmetic, bitwise, logical, comparison, assignment, channel.
+# Longest alternatives first so ``<<=`` is not read as ``<`` ``<=``.
+_HALSTEAD_OPERATOR_RE = (
+    r"<<=|>>=|&\^=|\.\.\."
+    r"|:=|\+=|-=|\*=|/=|%=|&=|\|=|\^=|==|!=|<=|>=|&&|\|\||<-|\+\+|--|<<|>>|&\^"
+    r"|[-+*/%&|^<>=!]"
+)
+# Operands: identifiers, numbers, string and rune literals.
+_HALSTEAD_OPERAND_RE = (
+    r'"(?:\\.|[^"\\\n])*"|`[^`]*`|\'(?:\\.|[^\'\\\n])*\''
+    r"|\b\d[\w.]*|\b[A-Za-z_]\w*"
+)
+_HALSTEAD_RE = re.compile(
+    rf"(?P<op>{_HALSTEAD_OPERATOR_RE})|(?P<operand>{_HALSTEAD_OPERAND_RE})",
+)
+_GO_KEYWORDS = frozenset(
+    {
+        "break",
+        "case",
+        "chan",
+        "const",
+        "continue",
+        "default",
+        "defer",
+        "else",
+        "fallthrough",
+        "for",
+        "func",
+        "go",
+        "goto",
+        "if",
+        "import",
+        "interface",
+        "map",
+        "package",
+        "range",
+        "return",
+        "select",
+        "struct",
+        "switch",
+        "type",
+        "var",
+    }
+)
+# Every token of a body, for structural normalisation.
+_BODY_TOKEN_RE = re.compile(rf"{_HALSTEAD_OPERAND_RE}|{_HALSTEAD_OPERATOR_RE}|\S")
+_IDENTIFIER_RE = re.compile(r"[A-Za-z_]\w*")
+
+# ── Error handling ──────────────────────────────────────────────────────
+_ERROR_RESULT_RE = re.compile(r"\berror\s*\)?\s*$")
+_ERRORF_WRAP_RE = re.compile(r'\bfmt\s*\.\s*Errorf\s*\(\s*(?:"(?:\\.|[^"\\\n])*%w|`[^`]*%w)')
+# ``a, b :=`` / ``err =``; lookbehind keeps ``==``, ``!=``, ``<=`` and ``>=`` out.
+_ASSIGN_RE = re.compile(
+    r"(?P<lhs>(?<![\w.])[\w.]+(?:\s*,\s*[\w.]+)*)\s*(?::=|(?<![!<>=:])=(?!=))"
+    r"(?P<rhs>[^\n;]*)",
+)
+_ERR_NAME_RE = re.compile(r"\w*[eE]rr")
+_CALL_START_RE = re.compile(r"[\w)\]]\s*\(")
+
+
+def _mask_noise(source: str) -> str:
+    """Blank out comments, string and rune literals.
+
+    Newlines are kept so that offsets and line numbers stay valid.
+
+    Args:
+        source: Go source text.
+
+    Returns:
+        Source of the same length with noise replaced by spaces.
+    """
+    return _NOISE_RE.sub(lambda m: re.sub(r"[^\n]", " ", m.group()), source)
+
+
+def _strip_comments(source: str) -> str:
+    """Replace comments with a space, keeping string and rune literals.
+
+    Literals are matched first, so ``//`` inside ``"http://a"`` or a
+    raw string does not start a comment.
+
+    Args:
+        source: Go source text.
+
+    Returns:
+        Source with every comment replaced by a single space.
+    """
+    return _NOISE_RE.sub(lambda m: " " if m.group()[0] == "/" else m.group(), source)
+
+
+def noise_spans(source: str) -> list[tuple[int, int]]:
+    """Return the offsets of comments and string, rune and raw-string literals.
+
+    These are the regions :func:`_mask_noise` blanks out.  Comment spans
+    are the ones whose text starts with ``/``.
+
+    Args:
+        source: Go source text.
+
+    Returns:
+        ``(start, end)`` offsets in source order, end exclusive.
+    """
+    return [match.span() for match in _NOISE_RE.finditer(source)]
+
+
+def cyclomatic_complexity(body: str) -> int:
+    """Compute McCabe cyclomatic complexity of a Go function body.
+
+    Starts at 1 and adds one for every ``if``, ``else if``, ``for``,
+    ``case``, ``select``, ``&&`` and ``||``.  Keywords inside comments
+    and string literals are ignored.
+
+    Args:
+        body: Source text of the function body.
+
+    Returns:
+        Cyclomatic complexity (minimum 1).
+    """
+    return 1 + len(_CC_PATTERN.findall(_mask_noise(body)))
+
+
+def halstead(body: str) -> HalsteadReport:
+    """Count Halstead operators and operands in a Go function body.
+
+    Operators are the arithmetic, bitwise, logical, comparison, assignment
+    and channel tokens.  Operands are identifiers (keywords excluded),
+    numeric literals, and string or rune literals.  Comments are ignored.
 
-def _approx_cc(body: str) -> int:
-    """Approximate cyclomatic complexity from a function body.
+    Args:
+        body: Source text of the function body.
+
+    Returns:
+        A :class:`HalsteadReport` with the raw counts.
+    """
+    operators: list[str] = []
+    operands: list[str] = []
+    for match in _HALSTEAD_RE.finditer(_strip_comments(body)):
+        if match.group("op"):
+            operators.append(match.group("op"))
+        elif match.group("operand") not in _GO_KEYWORDS:
+            operands.append(match.group("operand"))
+    return HalsteadReport(
+        distinct_operators=len(set(operators)),
+        distinct_operands=len(set(operands)),
+        total_operators=len(operators),
+        total_operands=len(operands),
+    )
+
+
+def cognitive_complexity(body: str) -> int:
+    """Compute SonarSource cognitive complexity of a Go function body.
+
+    * **+1 plus nesting depth** for ``if``, ``for``, ``switch`` and
+      ``select`` (a whole ``switch`` counts once, not per ``case``).
+    * **+1** flat for ``else if``, ``else``, ``goto`` and labelled
+      ``break``/``continue``.
+    * **+1** for each run of identical boolean operators, so
+      ``a && b && c`` scores 1 and ``a && b || c`` scores 2.
+    * Function literals increase nesting without scoring themselves.
 
     Args:
         body: Source text of the function body.
 
     Returns:
-        Estimated CC (minimum 1).
+        Cognitive complexity score (minimum 0).
     """
-    return 1 + len(_CC_PATTERN.findall(body))
+    score = 0
+    # One entry per open brace: ``True`` when the block adds nesting.
+    blocks: list[bool] = []
+    pending = False
+    block_at = -1
+    last_op = ""
+    masked = _mask_noise(body)
+    for match in _COGNITIVE_TOKEN_RE.finditer(masked):
+        token = match.group()
+        if token == "\n":
+            # A trailing operator continues the expression on the next line.
+            line = masked[masked.rfind("\n", 0, match.start()) + 1 : match.start()]
+            if line.rstrip().endswith(("&&", "||")):
+                continue
+        else:
+            token = " ".join(token.split())
+        if token in ("&&", "||"):
+            if token != last_op:
+                score += 1
+            last_op = token
+            continue
+        last_op = ""
+        if token == "{":
+            # Composite-literal braces in a header do not open the block.
+            opens = pending and match.start() == block_at
+            blocks.append(opens)
+            pending = pending and not opens
+        elif token == "}":
+            if blocks:
+                blocks.pop()
+        elif token in ("if", "for", "switch", "select"):
+            score += 1 + sum(blocks)
+        elif token in ("else if", "else", "goto") or token.startswith(("break", "continue")):
+            score += 1
+        if token in _NESTING_KEYWORDS:
+            pending = True
+            find = _body_brace if token == "func" else _block_brace
+            block_at = find(masked, match.end())
+    return score
+
+
+def nesting_depth(body: str) -> int:
+    """Return the deepest nesting of control-flow blocks in a Go function body.
+
+    ``if``, ``for`` (including ``range`` loops), ``switch`` and ``select``
+    blocks each add a level; ``else if`` / ``else`` branches sit at the
+    level of their ``if``.  Function literals and bare blocks add none.
+
+    Args:
+        body: Source text of the function body.
+
+    Returns:
+        Maximum depth (0 for straight-line code).
+    """
+    deepest = 0
+    # One entry per open brace: ``True`` when the block is a control structure.
+    blocks: list[bool] = []
+    pending = False
+    block_at = -1
+    masked = _mask_noise(body)
+    for match in _COGNITIVE_TOKEN_RE.finditer(masked):
+        token = " ".join(match.group().split())
+        if token == "{":
+            # Composite-literal braces in a header do not open the block.
+            opens = pending and match.start() == block_at
+            blocks.append(opens)
+            pending = pending and not opens
+            deepest = max(deepest, sum(blocks))
+        elif token == "}":
+            if blocks:
+                blocks.pop()
+        elif token in _CONTROL_KEYWORDS:
+            pending = True
+            block_at = _block_brace(masked, match.end())
+    return deepest
+
+
+def normalize_body(body: str) -> str:
+    """Reduce a Go function body to its structure.
+
+    Comments and layout are dropped and every identifier that is not a
+    keyword becomes ``_``; keywords, operators, punctuation and literal
+    values are kept.  Two bodies that differ only in naming normalise to
+    the same string.
+
+    Args:
+        body: Source text of the function body.
+
+    Returns:
+        Space-separated canonical token string.
+    """
+    tokens = _BODY_TOKEN_RE.findall(_strip_comments(body))
+    return " ".join(
+        "_" if _IDENTIFIER_RE.fullmatch(t) and t not in _GO_KEYWORDS else t for t in tokens
+    )
+
+
+def body_hash(body: str) -> str:
+    """Return the SHA-256 hex digest of :func:`normalize_body`.
+
+    Args:
+        body: Source text of the function body.
+
+    Returns:
+        64-character hex digest.
+    """
+    return hashlib.sha256(normalize_body(body).encode("utf-8")).hexdigest()
 
 
 def _extract_body(source: str, start: int) -> str:
     """Extract the brace-delimited body starting at *start*.
 
+    Braces are matched on :func:`_mask_noise` output, so ``{`` and ``}``
+    inside comments, strings and runes do not end the body early or run
+    it into the next function; the body itself is sliced from *source*.
+
     Args:
         source: Full source text.
         start: Index of the opening brace.
@@ -81,12 +450,13 @@ def _extract_body(source: str, start: int) -> str:
     Returns:
         The text between matching braces (exclusive).
     """
+    masked = _mask_noise(source)
     depth = 0
     i = start
-    while i < len(source):
-        if source[i] == "{":
+    while i < len(masked):
+        if masked[i] == "{":
             depth += 1
-        elif source[i] == "}":
+        elif masked[i] == "}":
             depth -= 1
             if depth == 0:
                 return source[start + 1 : i]
@@ -94,6 +464,133 @@ def _extract_body(source: str, start: int) -> str:
     return source[start + 1 :]
 
 
+def _body_brace(source: str, start: int) -> int:
+    """Return the index of the ``{`` that opens a function body.
+
+    Scans forward from *start* (inside or after the signature), skipping
+    parenthesised parameter lists and the braces of ``interface{}`` and
+    ``struct{...}`` types in the signature.
+
+    Args:
+        source: Full source text.
+        start: Offset to scan from.
+
+    Returns:
+        Index of the body brace, or ``-1`` if there is none.
+    """
+    depth = 0
+    i = start
+    while i < len(source):
+        char = source[i]
+        if char in "([":
+            depth += 1
+        elif char in ")]":
+            depth -= 1
+        elif char == "{" and depth <= 0:
+            if not _TYPE_LITERAL_RE.search(source, max(0, i - 16), i):
+                return i
+            i += len(_extract_body(source, i)) + 1
+        i += 1
+    return -1
+
+
+def _block_brace(source: str, start: int) -> int:
+    """Return the index of the ``{`` that opens a control statement's block.
+
+    Scans forward from *start* (just after ``if``, ``for``, ``switch`` or
+    ``select``), skipping brackets, parenthesised expressions and the
+    braces of composite literals such as ``[]string{"a"}`` in the header.
+
+    Args:
+        source: Full source text.
+        start: Offset to scan from.
+
+    Returns:
+        Index of the block brace, or ``-1`` if there is none.
+    """
+    depth = 0
+    i = start
+    while i < len(source):
+        char = source[i]
+        if char in "([":
+            depth += 1
+        elif char in ")]":
+            depth -= 1
+        elif char == "{":
+            if depth <= 0 and not _COMPOSITE_TYPE_RE.search(source, max(0, i - 64), i):
+                return i
+            i += len(_extract_body(source, i)) + 1
+        i += 1
+    return -1
+
+
+def struct_fields(body: str) -> tuple[tuple[str, ...], tuple[str, ...]]:
+    """Split a struct body into named fields and embedded types.
+
+    Embedded types keep their package qualifier (``io.Reader``) but lose
+    any pointer star and type arguments.  Fields of nested anonymous
+    structs are not counted.
+
+    Args:
+        body: Text between the struct's braces.
+
+    Returns:
+        ``(field_names, embedded_types)`` in declaration order.
+    """
+    fields: list[str] = []
+    embedded: list[str] = []
+    depth = 0
+    for raw in re.split(r"[\n;]", _mask_noise(body)):
+        decl = raw.strip()
+        if depth == 0 and decl:
+            match = _EMBEDDED_RE.match(decl)
+            if match:
+                embedded.append(match.group("type"))
+            else:
+                names = _FIELD_NAMES_RE.match(decl)
+                if names:
+                    fields.extend(n.strip() for n in names.group("names").split(","))
+        depth += decl.count("{") - decl.count("}")
+    return tuple(fields), tuple(embedded)
+
+
+def interface_members(
+    body: str, first_line: int
+) -> tuple[tuple[MethodReport, ...], tuple[str, ...]]:
+    """Split an interface body into method specs and embedded interfaces.
+
+    Type-set elements of constraint interfaces (``~int | ~string``) are
+    neither and are skipped.
+
+    Args:
+        body: Text between the interface's braces.
+        first_line: Line number of the opening brace.
+
+    Returns:
+        ``(method_specs, embedded_interfaces)`` in declaration order.
+    """
+    specs: list[MethodReport] = []
+    embedded: list[str] = []
+    for offset, raw in enumerate(_mask_noise(body).split("\n")):
+        decl = raw.strip()
+        spec = _METHOD_SPEC_RE.match(decl)
+        if spec:
+            line = first_line + offset
+            specs.append(
+                MethodReport(
+                    name=spec.group("name"),
+                    line=line,
+                    end_line=line,
+                    lines=1,
+                    parameters=tuple(_parse_params(spec.group("params"))),
+                    return_count=result_count(decl[spec.end() :].rstrip(";")),
+                )
+            )
+        elif decl and (match := _EMBEDDED_RE.match(decl)):
+            embedded.append(match.group("type"))
+    return tuple(specs), tuple(embedded)
+
+
 def _parse_params(raw: str) -> list[ParameterReport]:
     """Parse a Go parameter list string into reports.
 
@@ -125,6 +622,93 @@ def _parse_params(raw: str) -> list[ParameterReport]:
     return params
 
 
+def result_count(results: str) -> int:
+    """Count the values declared by a Go result list.
+
+    Args:
+        results: Signature text between the parameter list and the body,
+            e.g. ``int``, ``(int, error)`` or ``(n int, err error)``.
+
+    Returns:
+        Number of return values; grouped names (``(x, y int)``) count
+        once each.
+    """
+    results = results.strip()
+    if not results:
+        return 0
+    if not results.startswith("("):
+        return 1
+    count = 0
+    depth = 0
+    pending = False
+    for char in results[1:-1]:
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+        elif char == "," and depth == 0:
+            count += 1 if pending else 0
+            pending = False
+            continue
+        if not char.isspace():
+            pending = True
+    return count + (1 if pending else 0)
+
+
+def error_handling(results: str, body: str) -> ErrorHandlingReport:
+    """Classify how a Go function returns and handles errors.
+
+    Without type information this is a heuristic: errors are recognised
+    by name (``err``, ``closeErr``, ...) when assigned from a call, and a
+    ``_`` in the last position of a multi-value assignment from a call is
+    taken to discard an error.  Bare call statements are not inspected.
+
+    Args:
+        results: Signature text between the parameter list and the body.
+        body: Source text of the function body.
+
+    Returns:
+        The :class:`ErrorHandlingReport` for the function.
+    """
+    masked = _mask_noise(body)
+    assignments = list(_ASSIGN_RE.finditer(masked))
+    targets = [(m.start("lhs"), m.end("lhs")) for m in assignments]
+    checked = True
+    for match in assignments:
+        names = [n.strip() for n in match.group("lhs").split(",")]
+        rhs = match.group("rhs").strip()
+        if rhs.startswith("range") or not _CALL_START_RE.search(rhs):
+            continue
+        last = names[-1]
+        if last == "_" and len(names) > 1:
+            checked = False
+        elif _ERR_NAME_RE.fullmatch(last) and not _is_read(masked, last, match.end(), targets):
+            checked = False
+    return ErrorHandlingReport(
+        returns_error=bool(_ERROR_RESULT_RE.search(results.strip())),
+        all_errors_checked=checked,
+        uses_error_wrapping=bool(_ERRORF_WRAP_RE.search(_strip_comments(body))),
+    )
+
+
+def _is_read(masked: str, name: str, start: int, targets: list[tuple[int, int]]) -> bool:
+    """Return whether *name* is read after *start* before being reassigned.
+
+    Args:
+        masked: Function body with comments and literals masked.
+        name: Variable name.
+        start: Offset just after the assignment.
+        targets: ``(start, end)`` offsets of every assignment's left-hand side.
+
+    Returns:
+        ``True`` if the next mention of *name* is not another assignment to it.
+    """
+    mention = re.compile(rf"(?<![\w.]){re.escape(name)}\b").search(masked, start)
+    if mention is None:
+        return False
+    return not any(lo <= mention.start() < hi for lo, hi in targets)
+
+
 def _line_number(source: str, pos: int) -> int:
     """Return the 1-based line number at character position *pos*.
 
@@ -138,11 +722,511 @@ def _line_number(source: str, pos: int) -> int:
     return source[:pos].count("\n") + 1
 
 
+def doc_comment(lines: list[str], index: int) -> str | None:
+    """Return the first line of the doc comment above ``lines[index]``.
+
+    A Go doc comment is the run of ``//`` lines directly preceding a
+    declaration, with no blank line in between.
+
+    Args:
+        lines: Source lines.
+        index: 0-based index of the declaration line.
+
+    Returns:
+        The first non-empty comment line without the ``//`` marker, or
+        ``None`` when the declaration is undocumented.
+    """
+    start = index
+    while start > 0 and lines[start - 1].lstrip().startswith("//"):
+        start -= 1
+    for line in lines[start:index]:
+        text = line.lstrip()[2:].strip()
+        if text:
+            return text
+    return None
+
+
+def _function_spans(masked: str) -> list[tuple[str, int, int]]:
+    """Locate the body of every named function and method.
+
+    Args:
+        masked: Source with comments and literals masked.
+
+    Returns:
+        ``(qualified_name, body_start, body_end)`` tuples; methods are
+        named ``Type.method``.
+    """
+    spans: list[tuple[str, int, int]] = []
+    for match in _DECL_RE.finditer(masked):
+        brace = _body_brace(masked, match.end())
+        if brace == -1:
+            continue
+        body = _extract_body(masked, brace)
+        name = match.group("name")
+        if match.group("type"):
+            name = f"{match.group('type')}.{name}"
+        spans.append((name, brace, brace + len(body) + 1))
+    return spans
+
+
+def _call_graph(masked: str) -> CallGraph:
+    """Build the graph of calls between the functions declared in a file.
+
+    A plain call ``f(...)`` resolves to the top-level function ``f``; a
+    selector call ``r.m(...)`` inside a method resolves to ``Type.m`` when
+    ``r`` is that method's receiver.  Calls to names not declared in the
+    file are kept as unresolved so that a package-wide merge can link
+    them; calls through other values are dropped.
+
+    Args:
+        masked: Source with comments and literals masked.
+
+    Returns:
+        The file's call graph.
+    """
+    # (qualified name, receiver variable, receiver type, body)
+    bodies: list[tuple[str, str, str, str]] = []
+    for match in _DECL_RE.finditer(masked):
+        brace = _body_brace(masked, match.end())
+        if brace == -1:
+            continue
+        name = match.group("name")
+        type_name = match.group("type") or ""
+        if type_name:
+            name = f"{type_name}.{name}"
+        bodies.append((name, match.group("recv") or "", type_name, _extract_body(masked, brace)))
+
+    nodes = {name for name, _, _, _ in bodies}
+    edges: set[tuple[str, str]] = set()
+    unresolved: set[tuple[str, str]] = set()
+    for caller, recv, type_name, body in bodies:
+        for call in _CALL_RE.finditer(body):
+            if call.group("recv") is None:
+                callee = call.group("name")
+            elif type_name and call.group("recv") == recv:
+                callee = f"{type_name}.{call.group('name')}"
+            else:
+                continue
+            (edges if callee in nodes else unresolved).add((caller, callee))
+    return CallGraph(
+        nodes=tuple(sorted(nodes)),
+        edges=tuple(sorted(edges)),
+        unresolved=tuple(sorted(unresolved)),
+    )
+
+
+def _locate(masked: str, spans: list[tuple[str, int, int]], pos: int) -> tuple[int, int, str]:
+    """Return ``(line, column, enclosing_function)`` for offset *pos*.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+        pos: Character offset.
+
+    Returns:
+        1-based line and column, and the enclosing function name or ``""``.
+    """
+    enclosing = next((name for name, start, end in spans if start < pos < end), "")
+    return _line_number(masked, pos), pos - masked.rfind("\n", 0, pos), enclosing
+
+
+def _call_sites(
+    masked: str, spans: list[tuple[str, int, int]], callee: str
+) -> tuple[CallSite, ...]:
+    """Find bare calls to *callee* in masked source.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+        callee: Function name.
+
+    Returns:
+        Call sites in source order.
+    """
+    pattern = re.compile(rf"(?<![\w.]){re.escape(callee)}\s*\(")
+    sites: list[CallSite] = []
+    for match in pattern.finditer(masked):
+        line, column, enclosing = _locate(masked, spans, match.start())
+        sites.append(CallSite(name=callee, line=line, column=column, function=enclosing))
+    return tuple(sites)
+
+
+def call_sites(source: str, callee: str) -> tuple[CallSite, ...]:
+    """Find calls to the built-in *callee* in a Go source file.
+
+    Only bare calls count: ``panic(x)`` matches, ``log.Panic(x)`` and
+    ``t.panic(x)`` do not.  Calls inside comments and strings are ignored.
+
+    Args:
+        source: Full Go source text.
+        callee: Function name, e.g. ``"panic"`` or ``"recover"``.
+
+    Returns:
+        Call sites in source order.
+    """
+    masked = _mask_noise(source)
+    return _call_sites(masked, _function_spans(masked), callee)
+
+
+def symbol_usages(source: str, name: str) -> tuple[UsageSite, ...]:
+    """Find every reference to *name* in a Go source file.
+
+    The whole file is scanned, so package-level declarations, signatures
+    and interface method specs are covered as well as function bodies.
+    The declaration of *name* itself (``type Name``, ``func Name``, an
+    interface method spec, a struct field) is not a usage.  Occurrences are classified as:
+
+    * ``field_access`` — after a dot without a following ``(`` (``c.Name``),
+      or as a key in a composite literal (``T{Name: 1}``);
+    * ``call`` — followed by ``(`` outside a signature (``Name(x)``, ``c.Name()``);
+    * ``composite_literal`` — followed by ``{`` outside a signature (``Name{}``);
+    * ``type_ref`` — anything else (``*Name``, ``[]Name``, ``x Name``).
+
+    Args:
+        source: Full Go source text.
+        name: Identifier to look for (no package qualifier).
+
+    Returns:
+        Usage sites in source order.
+    """
+    masked = _mask_noise(source)
+    spans = _function_spans(masked)
+    signatures: list[tuple[int, int]] = []
+    for match in _DECL_RE.finditer(masked):
+        brace = _body_brace(masked, match.end())
+        signatures.append((match.start(), brace if brace != -1 else match.end()))
+    interfaces = _type_bodies(_INTERFACE_RE, masked)
+    structs = _type_bodies(_STRUCT_RE, masked)
+
+    sites: list[UsageSite] = []
+    for match in re.finditer(rf"(?<!\w){re.escape(name)}\b", masked):
+        pos = match.start()
+        line_start = masked.rfind("\n", 0, pos) + 1
+        before = masked[line_start:pos]
+        after = masked[match.end() :].lstrip(" \t")
+        if _DECLARED_NAME_RE.search(before):
+            continue
+        if not before.strip() and after.startswith("(") and _within(interfaces, pos):
+            continue
+        if not before.strip() and after[:1] not in ("\n", "}", "") and _within(structs, pos):
+            continue
+        in_signature = _within(signatures, pos)
+        if before.rstrip().endswith("."):
+            kind = UsageKind.CALL if after.startswith("(") else UsageKind.FIELD_ACCESS
+        elif _LITERAL_KEY_RE.match(after) and before.rstrip()[-1:] in ("{", ","):
+            kind = UsageKind.FIELD_ACCESS
+        elif after.startswith("(") and not in_signature:
+            kind = UsageKind.CALL
+        elif after.startswith("{") and not in_signature:
+            kind = UsageKind.COMPOSITE_LITERAL
+        else:
+            kind = UsageKind.TYPE_REF
+        line, column, enclosing = _locate(masked, spans, pos)
+        sites.append(UsageSite(name=name, kind=kind, line=line, column=column, function=enclosing))
+    return tuple(sites)
+
+
+def _type_bodies(pattern: re.Pattern[str], masked: str) -> list[tuple[int, int]]:
+    """Return the brace spans of the type declarations matched by *pattern*.
+
+    Args:
+        pattern: :data:`_STRUCT_RE` or :data:`_INTERFACE_RE`.
+        masked: Masked source text.
+
+    Returns:
+        ``(open_brace, after_close_brace)`` offsets.
+    """
+    spans: list[tuple[int, int]] = []
+    for match in pattern.finditer(masked):
+        brace = match.end() - 1
+        spans.append((brace, brace + len(_extract_body(masked, brace)) + 1))
+    return spans
+
+
+def _within(spans: list[tuple[int, int]], pos: int) -> bool:
+    """Return whether *pos* lies inside any ``(start, end)`` span.
+
+    Args:
+        spans: Half-open offset ranges.
+        pos: Character offset.
+
+    Returns:
+        ``True`` if some span contains *pos*.
+    """
+    return any(start <= pos < end for start, end in spans)
+
+
+def _goroutine_sites(masked: str, spans: list[tuple[str, int, int]]) -> tuple[CallSite, ...]:
+    """Find ``go`` statements in masked source.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+
+    Returns:
+        One site per statement, named after the spawned callee.
+    """
+    sites: list[CallSite] = []
+    for match in _GO_STMT_RE.finditer(masked):
+        line, column, enclosing = _locate(masked, spans, match.start())
+        sites.append(
+            CallSite(name=match.group("target"), line=line, column=column, function=enclosing)
+        )
+    return tuple(sites)
+
+
+def _channel_ops(masked: str, spans: list[tuple[str, int, int]]) -> tuple[ChannelOp, ...]:
+    """Find channel sends, receives, ``make(chan ...)`` and ``close(...)``.
+
+    ``<-`` is a send when a value expression (identifier, ``)`` or ``]``)
+    precedes it on the same line, and a receive otherwise.  Arrows that are
+    part of a directional channel type (``chan<-``, ``<-chan``) are skipped.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+
+    Returns:
+        Operations in source order.
+    """
+    found: list[tuple[int, ChannelOpKind]] = []
+    for match in _CHAN_OP_RE.finditer(masked):
+        pos = match.start()
+        if match.group("make"):
+            found.append((pos, ChannelOpKind.MAKE))
+        elif match.group("close"):
+            found.append((pos, ChannelOpKind.CLOSE))
+        else:
+            before = masked[masked.rfind("\n", 0, pos) + 1 : pos].rstrip()
+            after = masked[match.end() :].lstrip(" \t")
+            prev_word = _TRAILING_WORD_RE.search(before)
+            if (prev_word and prev_word.group() == "chan") or _CHAN_TYPE_RE.match(after):
+                continue
+            if before.endswith((")", "]")):
+                is_send = True
+            else:
+                is_send = prev_word is not None and prev_word.group() not in _GO_KEYWORDS
+            found.append((pos, ChannelOpKind.SEND if is_send else ChannelOpKind.RECEIVE))
+
+    ops: list[ChannelOp] = []
+    for pos, kind in found:
+        line, column, enclosing = _locate(masked, spans, pos)
+        ops.append(ChannelOp(kind=kind, line=line, column=column, function=enclosing))
+    return tuple(ops)
+
+
+def _type_assertions(
+    masked: str, spans: list[tuple[str, int, int]]
+) -> tuple[TypeAssertionSite, ...]:
+    """Find ``x.(T)`` type assertions, excluding ``x.(type)`` switches.
+
+    The comma-ok form is recognised when the assertion is the whole
+    right-hand side of a two-name assignment (``v, ok := x.(T)``).
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+
+    Returns:
+        Assertions in source order.
+    """
+    sites: list[TypeAssertionSite] = []
+    for match in _TYPE_ASSERT_RE.finditer(masked):
+        type_name = " ".join(match.group("type").split())
+        if type_name == "type":
+            continue
+        prefix = masked[masked.rfind("\n", 0, match.start()) + 1 : match.start()]
+        suffix = masked[match.end() :].lstrip(" \t")
+        comma_ok = bool(_COMMA_OK_RE.search(prefix)) and (not suffix or suffix[0] in ";\n")
+        line, column, enclosing = _locate(masked, spans, match.start())
+        sites.append(
+            TypeAssertionSite(
+                type_name=type_name,
+                comma_ok=comma_ok,
+                line=line,
+                column=column,
+                function=enclosing,
+            )
+        )
+    return tuple(sites)
+
+
+def _type_switches(
+    masked: str, spans: list[tuple[str, int, int]]
+) -> tuple[TypeSwitchSite, ...]:
+    """Find ``switch x.(type)`` statements and count their cases.
+
+    Only ``case`` clauses of the switch itself are counted; those of
+    nested switches and ``select`` statements are not.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+
+    Returns:
+        Type switches in source order.
+    """
+    sites: list[TypeSwitchSite] = []
+    for match in _SWITCH_RE.finditer(masked):
+        brace = _block_brace(masked, match.end())
+        if brace < 0 or not _TYPE_GUARD_RE.search(masked, match.end(), brace):
+            continue
+        body = _extract_body(masked, brace)
+        depth = 0
+        cases = 0
+        for token in _CASE_OR_BRACE_RE.finditer(body):
+            if token.group() == "{":
+                depth += 1
+            elif token.group() == "}":
+                depth -= 1
+            elif depth == 0:
+                cases += 1
+        line, column, enclosing = _locate(masked, spans, match.start())
+        sites.append(TypeSwitchSite(cases=cases, line=line, column=column, function=enclosing))
+    return tuple(sites)
+
+
+def _missing_default_switches(
+    masked: str, spans: list[tuple[str, int, int]]
+) -> tuple[SwitchSite, ...]:
+    """Find ``switch`` statements that have no ``default`` clause.
+
+    Expression and type switches are both checked; ``select`` statements,
+    where omitting ``default`` is the idiomatic blocking form, are not.
+    Clauses of nested statements are not attributed to the outer switch.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+
+    Returns:
+        Switches lacking ``default``, in source order.
+    """
+    sites: list[SwitchSite] = []
+    for match in _SWITCH_RE.finditer(masked):
+        brace = _block_brace(masked, match.end())
+        if brace < 0:
+            continue
+        body = _extract_body(masked, brace)
+        depth = 0
+        cases = 0
+        has_default = False
+        for token in _CLAUSE_OR_BRACE_RE.finditer(body):
+            if token.group() == "{":
+                depth += 1
+            elif token.group() == "}":
+                depth -= 1
+            elif depth == 0 and token.group() == "case":
+                cases += 1
+            elif depth == 0:
+                has_default = True
+        if has_default:
+            continue
+        line, column, enclosing = _locate(masked, spans, match.start())
+        sites.append(
+            SwitchSite(
+                cases=cases,
+                line=line,
+                column=column,
+                function=enclosing,
+                is_type_switch=bool(_TYPE_GUARD_RE.search(masked, match.end(), brace)),
+            )
+        )
+    return tuple(sites)
+
+
+def _global_vars(masked: str, spans: list[tuple[str, int, int]]) -> tuple[GlobalVarSite, ...]:
+    """Find package-level ``var`` declarations holding mutable state.
+
+    Only ``var`` at brace depth 0 is package-level; declarations inside
+    function bodies and function literals are skipped.  Both ``var x T``
+    and parenthesised ``var ( ... )`` blocks are read.
+    Without a declared type, a composite literal value (``sync.Once{}``)
+    supplies it.  ``sync.Once``, ``sync.Mutex`` and ``sync.RWMutex``
+    variables and the blank identifier are skipped.
+
+    Args:
+        masked: Masked source text.
+        spans: Output of :func:`_function_spans`.
+
+    Returns:
+        Global variables in source order.
+    """
+    sites: list[GlobalVarSite] = []
+    depth = 0
+    scanned = 0
+    for match in _VAR_RE.finditer(masked):
+        gap = masked[scanned : match.start()]
+        depth += gap.count("{") - gap.count("}")
+        scanned = match.start()
+        if depth > 0 or _locate(masked, spans, match.start())[2]:
+            continue
+        line = _line_number(masked, match.start())
+        if match.group("block") is None:
+            specs = [(line, masked[match.end() :].split("\n", 1)[0])]
+        else:
+            specs = []
+            nested = 0
+            for offset, raw in enumerate(masked[match.end() :].split("\n")):
+                if nested == 0 and raw.strip():
+                    specs.append((line + offset, raw))
+                nested += sum(raw.count(c) for c in "({[") - sum(raw.count(c) for c in ")}]")
+                if nested < 0:
+                    specs[-1] = (specs[-1][0], specs[-1][1].rsplit(")", 1)[0])
+                    break
+        for spec_line, raw in specs:
+            spec = _VAR_SPEC_RE.match(raw.strip())
+            if spec is None:
+                continue
+            type_name = spec.group("type").strip()
+            if not type_name and spec.group("value"):
+                literal = _COMPOSITE_LITERAL_RE.match(spec.group("value").strip())
+                type_name = literal.group("type") if literal else ""
+            if type_name.lstrip("*") in _INTENTIONAL_GLOBALS:
+                continue
+            sites.extend(
+                GlobalVarSite(name=name, type_name=type_name, line=spec_line)
+                for name in (n.strip() for n in spec.group("names").split(","))
+                if name != "_"
+            )
+    return tuple(sites)
+
+
+def source_insights(source: str) -> FileExtras:
+    """Collect per-file Go call sites for the ``FileReport``.
+
+    Shared by the regex and tree-sitter Go parsers.
+
+    Args:
+        source: Full Go source text.
+
+    Returns:
+        The ``panic_sites``, ``recover_sites``, ``goroutine_sites``,
+        ``channel_ops``, ``type_assertions``, ``type_switches``,
+        ``global_vars``, ``missing_default_switches`` and ``call_graph``
+        fields.
+    """
+    masked = _mask_noise(source)
+    spans = _function_spans(masked)
+    return {
+        "panic_sites": _call_sites(masked, spans, "panic"),
+        "recover_sites": _call_sites(masked, spans, "recover"),
+        "goroutine_sites": _goroutine_sites(masked, spans),
+        "channel_ops": _channel_ops(masked, spans),
+        "type_assertions": _type_assertions(masked, spans),
+        "type_switches": _type_switches(masked, spans),
+        "global_vars": _global_vars(masked, spans),
+        "missing_default_switches": _missing_default_switches(masked, spans),
+        "call_graph": _call_graph(masked),
+    }
+
+
 class GoParser(AbstractParser):
     """Parser for Go source files using regex extraction.
 
     Extracts structs, interfaces, functions, methods with receivers,
-    parameters, imports, and approximate cyclomatic complexity.
+    parameters, imports, and cyclomatic complexity, cognitive complexity and
+    Halstead counts for every function and method.
     """
 
     @property
@@ -160,6 +1244,11 @@ class GoParser(AbstractParser):
         """Return ``('//',)``."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')``."""
+        return ("/*", "*/")
+
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
         """Extract struct and interface definitions.
 
@@ -172,6 +1261,7 @@ class GoParser(AbstractParser):
         """
         classes: list[ClassReport] = []
         seen: set[str] = set()
+        lines = source.splitlines()
 
         # Extract structs
         for match in _STRUCT_RE.finditer(source):
@@ -181,6 +1271,7 @@ class GoParser(AbstractParser):
             seen.add(name)
 
             body = _extract_body(source, match.end() - 1)
+            fields, embedded = struct_fields(body)
 
             # Find methods with this struct as receiver
             methods = self._find_methods_for_type(source, name)
@@ -196,6 +1287,9 @@ class GoParser(AbstractParser):
                     end_line=end_line,
                     lines=end_line - line + 1,
                     methods=tuple(methods),
+                    attributes=fields,
+                    base_classes=embedded,
+                    docstring=doc_comment(lines, line - 1),
                 )
             )
 
@@ -207,6 +1301,7 @@ class GoParser(AbstractParser):
             seen.add(name)
 
             body = _extract_body(source, match.end() - 1)
+            specs, embedded = interface_members(body, _line_number(source, match.end() - 1))
             line = _line_number(source, match.start())
             end_pos = match.end() - 1 + len(body) + 2
             end_line = _line_number(source, min(end_pos, len(source) - 1))
@@ -217,7 +1312,10 @@ class GoParser(AbstractParser):
                     line=line,
                     end_line=end_line,
                     lines=end_line - line + 1,
+                    base_classes=embedded,
+                    docstring=doc_comment(lines, line - 1),
                     decorators=("interface",),
+                    interface_methods=specs,
                 )
             )
 
@@ -226,6 +1324,9 @@ class GoParser(AbstractParser):
     def _find_methods_for_type(self, source: str, type_name: str) -> list[MethodReport]:
         """Find all methods with a receiver of the given type.
 
+        Each method is tagged with a ``pointer_receiver`` or
+        ``value_receiver`` decorator.
+
         Args:
             source: Full Go source code.
             type_name: Struct type name to match.
@@ -234,6 +1335,7 @@ class GoParser(AbstractParser):
             List of ``MethodReport`` objects.
         """
         methods: list[MethodReport] = []
+        lines = source.splitlines()
 
         for match in _METHOD_RE.finditer(source):
             if match.group("type") != type_name:
@@ -242,23 +1344,36 @@ class GoParser(AbstractParser):
             params = _parse_params(match.group("params"))
 
             # Find the opening brace after the signature
-            rest = source[match.end() :]
-            brace_idx = rest.find("{")
-            if brace_idx == -1:
+            brace = _body_brace(source, match.end())
+            if brace == -1:
                 continue
-            body = _extract_body(source, match.end() + brace_idx)
-            cc = _approx_cc(body)
+            body = _extract_body(source, brace)
+            cc = cyclomatic_complexity(body)
+            cognitive = cognitive_complexity(body)
+            nesting = nesting_depth(body)
+            counts = halstead(body)
+            returns = result_count(source[match.end() : brace])
             line = _line_number(source, match.start())
-            body_lines = body.count("\n") + 1
+            end_line = _line_number(source, brace + len(body) + 1)
 
             methods.append(
                 MethodReport(
                     name=name,
                     line=line,
-                    end_line=line + body_lines,
-                    lines=body_lines,
+                    end_line=end_line,
+                    lines=end_line - line + 1,
                     parameters=tuple(params),
                     cyclomatic_complexity=cc,
+                    cognitive_complexity=cognitive,
+                    nesting_depth=nesting,
+                    halstead=counts,
+                    return_count=returns,
+                    body_hash=body_hash(body),
+                    error_handling=error_handling(source[match.end() : brace], body),
+                    docstring=doc_comment(lines, line - 1),
+                    decorators=(
+                        "pointer_receiver" if match.group("ptr") else "value_receiver",
+                    ),
                 )
             )
 
@@ -275,6 +1390,7 @@ class GoParser(AbstractParser):
             List of ``MethodReport`` objects.
         """
         functions: list[MethodReport] = []
+        lines = source.splitlines()
 
         # Collect method positions to exclude
         method_positions: set[int] = set()
@@ -290,28 +1406,61 @@ class GoParser(AbstractParser):
             params = _parse_params(match.group("params"))
 
             # Find the opening brace
-            rest = source[match.end() :]
-            brace_idx = rest.find("{")
-            if brace_idx == -1:
+            brace = _body_brace(source, match.end())
+            if brace == -1:
                 continue
-            body = _extract_body(source, match.end() + brace_idx)
-            cc = _approx_cc(body)
+            body = _extract_body(source, brace)
+            cc = cyclomatic_complexity(body)
+            cognitive = cognitive_complexity(body)
+            nesting = nesting_depth(body)
+            counts = halstead(body)
+            returns = result_count(source[match.end() : brace])
             line = _line_number(source, match.start())
-            body_lines = body.count("\n") + 1
+            end_line = _line_number(source, brace + len(body) + 1)
 
             functions.append(
                 MethodReport(
                     name=name,
                     line=line,
-                    end_line=line + body_lines,
-                    lines=body_lines,
+                    end_line=end_line,
+                    lines=end_line - line + 1,
                     parameters=tuple(params),
                     cyclomatic_complexity=cc,
+                    cognitive_complexity=cognitive,
+                    nesting_depth=nesting,
+                    halstead=counts,
+                    return_count=returns,
+                    body_hash=body_hash(body),
+                    error_handling=error_handling(source[match.end() : brace], body),
+                    docstring=doc_comment(lines, line - 1),
                 )
             )
 
         return functions
 
+    def _extract_extras(self, source: str) -> FileExtras:
+        """Collect call sites, concurrency and type-assertion sites.
+
+        Args:
+            source: Go source code.
+
+        Returns:
+            The fields from :func:`source_insights`.
+        """
+        return source_insights(source)
+
+    def _detect_package(self, source: str) -> str | None:
+        """Detect the ``package`` clause.
+
+        Args:
+            source: Go source code.
+
+        Returns:
+            The package name, or ``None`` if absent.
+        """
+        match = _PACKAGE_RE.search(_mask_noise(source))
+        return match.group("name") if match else None
+
     def _detect_imports(self, source: str) -> list[str]:
         """Detect imported package names from ``import`` statements.
 
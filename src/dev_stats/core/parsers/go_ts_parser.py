@@ -2,17 +2,32 @@
 
 from __future__ import annotations
 
+import dataclasses
 import logging
 from typing import TYPE_CHECKING, Any
 
 from dev_stats.core.models import ClassReport, MethodReport, ParameterReport
+from dev_stats.core.parsers.go_parser import body_hash, error_handling, source_insights
 from dev_stats.core.parsers.tree_sitter_base import TreeSitterBase
 
 if TYPE_CHECKING:
     from pathlib import Path
 
+    from dev_stats.core.models import ErrorHandlingReport, FileExtras
+
 logger = logging.getLogger(__name__)
 
+# Statements that open a control-flow nesting level.
+_NESTING_NODE_TYPES = frozenset(
+    {
+        "if_statement",
+        "for_statement",
+        "expression_switch_statement",
+        "type_switch_statement",
+        "select_statement",
+    }
+)
+
 
 class GoTreeSitterParser(TreeSitterBase):
     """Parser for Go source files using tree-sitter.
@@ -41,6 +56,11 @@ class GoTreeSitterParser(TreeSitterBase):
         """Return ``('//',)``."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')``."""
+        return ("/*", "*/")
+
     # ── Class extraction ─────────────────────────────────────────────
 
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
@@ -67,6 +87,8 @@ class GoTreeSitterParser(TreeSitterBase):
             receiver_type = self._extract_receiver_type(node)
             if receiver_type:
                 report = self._build_go_method_report(node)
+                kind = "pointer_receiver" if self._is_pointer_receiver(node) else "value_receiver"
+                report = dataclasses.replace(report, decorators=(kind,))
                 receiver_methods.setdefault(receiver_type, []).append(report)
 
         # Second pass: collect structs and interfaces
@@ -87,6 +109,7 @@ class GoTreeSitterParser(TreeSitterBase):
                     start_line = node.start_point[0] + 1
                     end_line = node.end_point[0] + 1
                     methods = tuple(receiver_methods.get(name, []))
+                    fields, embedded = self._struct_fields(struct_type)
                     classes.append(
                         ClassReport(
                             name=name,
@@ -94,23 +117,98 @@ class GoTreeSitterParser(TreeSitterBase):
                             end_line=end_line,
                             lines=end_line - start_line + 1,
                             methods=methods,
+                            attributes=fields,
+                            base_classes=embedded,
+                            docstring=self._doc_comment(node),
                         )
                     )
                 elif interface_type is not None:
                     start_line = node.start_point[0] + 1
                     end_line = node.end_point[0] + 1
+                    specs, embedded = self._interface_members(interface_type)
                     classes.append(
                         ClassReport(
                             name=name,
                             line=start_line,
                             end_line=end_line,
                             lines=end_line - start_line + 1,
+                            base_classes=embedded,
+                            docstring=self._doc_comment(node),
                             decorators=("interface",),
+                            interface_methods=specs,
                         )
                     )
 
         return classes
 
+    def _struct_fields(self, struct_type: Any) -> tuple[tuple[str, ...], tuple[str, ...]]:
+        """Split a ``struct_type`` into named fields and embedded types.
+
+        Args:
+            struct_type: A ``struct_type`` tree-sitter node.
+
+        Returns:
+            ``(field_names, embedded_types)``; embedded types lose any
+            pointer star and type arguments, as in the regex parser.
+        """
+        fields: list[str] = []
+        embedded: list[str] = []
+        field_list = self._child_by_type(struct_type, "field_declaration_list")
+        if field_list is None:
+            return (), ()
+        for decl in self._find_nodes(field_list, "field_declaration"):
+            names = self._find_nodes(decl, "field_identifier")
+            if names:
+                fields.extend(self._node_text(n) for n in names)
+                continue
+            types = self._find_nodes(
+                decl, "type_identifier", "qualified_type", "pointer_type", "generic_type"
+            )
+            if types:
+                text = self._node_text(types[0]).lstrip("*").strip()
+                embedded.append(text.split("[", 1)[0])
+        return tuple(fields), tuple(embedded)
+
+    def _interface_members(
+        self, interface_type: Any
+    ) -> tuple[tuple[MethodReport, ...], tuple[str, ...]]:
+        """Split an ``interface_type`` into method specs and embedded interfaces.
+
+        Accepts both the ``method_spec`` and the newer ``method_elem`` node
+        names used by tree-sitter-go releases.
+
+        Args:
+            interface_type: An ``interface_type`` tree-sitter node.
+
+        Returns:
+            ``(method_specs, embedded_interfaces)``, as in the regex parser.
+        """
+        specs: list[MethodReport] = []
+        embedded: list[str] = []
+        for child in interface_type.children:
+            if child.type in ("method_spec", "method_elem"):
+                name_node = self._child_by_type(child, "field_identifier")
+                line = child.start_point[0] + 1
+                specs.append(
+                    MethodReport(
+                        name=self._node_text(name_node) if name_node else "<anonymous>",
+                        line=line,
+                        end_line=child.end_point[0] + 1,
+                        lines=child.end_point[0] - child.start_point[0] + 1,
+                        parameters=self._extract_go_params(
+                            self._child_by_type(child, "parameter_list")
+                        ),
+                        return_count=self._return_count(child),
+                    )
+                )
+            elif child.type in ("type_elem", "constraint_elem", "type_identifier"):
+                text = self._node_text(child).strip()
+                if "|" not in text and "~" not in text:
+                    embedded.append(text.split("[", 1)[0])
+            elif child.type == "qualified_type":
+                embedded.append(self._node_text(child))
+        return tuple(specs), tuple(embedded)
+
     def _extract_receiver_type(self, node: Any) -> str:
         """Extract the receiver type name from a method_declaration node.
 
@@ -140,6 +238,23 @@ class GoTreeSitterParser(TreeSitterBase):
                         return self._node_text(type_id)
         return ""
 
+    def _is_pointer_receiver(self, node: Any) -> bool:
+        """Return whether a method_declaration has a pointer receiver.
+
+        Args:
+            node: A ``method_declaration`` tree-sitter node.
+
+        Returns:
+            ``True`` for ``(s *Server)``, ``False`` for ``(s Server)``.
+        """
+        param_list = self._child_by_type(node, "parameter_list")
+        if param_list is None:
+            return False
+        for child in param_list.children:
+            if child.type == "parameter_declaration":
+                return self._child_by_type(child, "pointer_type") is not None
+        return False
+
     def _build_go_method_report(self, node: Any) -> MethodReport:
         """Build a ``MethodReport`` from a Go method/function node.
 
@@ -180,9 +295,113 @@ class GoTreeSitterParser(TreeSitterBase):
             parameters=params,
             cyclomatic_complexity=self._cyclomatic_complexity(node),
             cognitive_complexity=self._cognitive_complexity(node),
-            nesting_depth=0,
+            nesting_depth=self._nesting_depth(node),
+            docstring=self._doc_comment(node),
+            return_count=self._return_count(node),
+            body_hash=self._body_hash(node),
+            error_handling=self._error_handling(node),
         )
 
+    def _error_handling(self, node: Any) -> ErrorHandlingReport | None:
+        """Classify error handling of a function or method node.
+
+        Args:
+            node: A ``method_declaration`` or ``function_declaration`` node.
+
+        Returns:
+            The :func:`~dev_stats.core.parsers.go_parser.error_handling`
+            result, or ``None`` without a body.
+        """
+        block = node.child_by_field_name("body")
+        if block is None:
+            return None
+        result = node.child_by_field_name("result")
+        results = self._node_text(result) if result is not None else ""
+        return error_handling(results, self._node_text(block)[1:-1])
+
+    def _body_hash(self, node: Any) -> str | None:
+        """Hash the normalised body of a function or method node.
+
+        Args:
+            node: A ``method_declaration`` or ``function_declaration`` node.
+
+        Returns:
+            The :func:`~dev_stats.core.parsers.go_parser.body_hash` of the
+            block between its braces, or ``None`` without a body.
+        """
+        block = node.child_by_field_name("body")
+        if block is None:
+            return None
+        return body_hash(self._node_text(block)[1:-1])
+
+    def _return_count(self, node: Any) -> int:
+        """Count the declared return values of a function or method.
+
+        Args:
+            node: A ``method_declaration``, ``function_declaration`` or
+                interface method spec node.
+
+        Returns:
+            Number of return values, 0 when there is no result.
+        """
+        result = node.child_by_field_name("result")
+        if result is None:
+            return 0
+        if result.type == "parameter_list":
+            return len(self._extract_go_params(result))
+        return 1
+
+    @staticmethod
+    def _nesting_depth(node: Any) -> int:
+        """Return the deepest control-flow nesting inside *node*.
+
+        An ``else if`` is parsed as an ``if_statement`` child of the
+        preceding ``if`` and stays at its level.
+
+        Args:
+            node: A ``method_declaration`` or ``function_declaration`` node.
+
+        Returns:
+            Maximum depth (0 for straight-line code).
+        """
+
+        def walk(n: Any, depth: int) -> int:
+            deepest = depth
+            for child in n.children:
+                level = depth
+                if child.type in _NESTING_NODE_TYPES and not (
+                    child.type == "if_statement" and n.type == "if_statement"
+                ):
+                    level += 1
+                deepest = max(deepest, walk(child, level))
+            return deepest
+
+        return walk(node, 0)
+
+    def _doc_comment(self, node: Any) -> str | None:
+        """Return the first line of the doc comment attached to *node*.
+
+        Collects the ``//`` comment siblings directly above the
+        declaration, stopping at the first gap of a blank line.
+
+        Args:
+            node: A declaration node.
+
+        Returns:
+            The first non-empty comment line, or ``None``.
+        """
+        comments: list[str] = []
+        row = node.start_point[0]
+        prev = node.prev_sibling
+        while prev is not None and prev.type == "comment" and prev.end_point[0] == row - 1:
+            text = self._node_text(prev)
+            if not text.startswith("//"):
+                break
+            comments.insert(0, text[2:].strip())
+            row = prev.start_point[0]
+            prev = prev.prev_sibling
+        return next((c for c in comments if c), None)
+
     @staticmethod
     def _extract_go_params(params_node: Any) -> tuple[ParameterReport, ...]:
         """Extract parameters from a Go ``parameter_list`` node.
@@ -281,6 +500,38 @@ class GoTreeSitterParser(TreeSitterBase):
 
     # ── Import detection ─────────────────────────────────────────────
 
+    def _extract_extras(self, source: str) -> FileExtras:
+        """Collect call sites, concurrency and type-assertion sites.
+
+        Shares the text-based scanner with the regex Go parser.
+
+        Args:
+            source: Go source code.
+
+        Returns:
+            The fields from :func:`source_insights`.
+        """
+        return source_insights(source)
+
+    def _detect_package(self, source: str) -> str | None:
+        """Detect the ``package`` clause using tree-sitter.
+
+        Args:
+            source: Go source code.
+
+        Returns:
+            The package name, or ``None`` if absent.
+        """
+        root = self._parse_tree(source)
+        if root is None:
+            return None
+        for node in root.children:
+            if node.type == "package_clause":
+                name_node = self._child_by_type(node, "package_identifier")
+                if name_node is not None:
+                    return self._node_text(name_node)
+        return None
+
     def _detect_imports(self, source: str) -> list[str]:
         """Detect imported package names using tree-sitter.
 
@@ -164,6 +164,11 @@ class JavaParser(AbstractParser):
         """Return ``('//',)``."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')``."""
+        return ("/*", "*/")
+
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
         """Extract class, interface, and enum definitions.
 
@@ -29,6 +29,8 @@ _METHOD_RE = re.compile(
     r"(?P<name>\w+)\s*\((?P<params>[^)]*)\)\s*\{",
     re.MULTILINE,
 )
+# Statements inside method bodies that the method pattern also matches.
+_CONTROL_KEYWORDS = frozenset({"if", "for", "while", "switch", "catch", "with"})
 
 # ── Top-level function ──────────────────────────────────────────────────
 _FUNCTION_RE = re.compile(
@@ -160,6 +162,11 @@ class JavaScriptParser(AbstractParser):
         """Return ``('//',)``."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')``."""
+        return ("/*", "*/")
+
     def _extract_classes(self, source: str, path: Path) -> list[ClassReport]:
         """Extract ES6 class definitions.
 
@@ -219,7 +226,7 @@ class JavaScriptParser(AbstractParser):
 
         for match in _METHOD_RE.finditer(body):
             name = match.group("name")
-            if name in seen:
+            if name in seen or name in _CONTROL_KEYWORDS:
                 continue
             seen.add(name)
 
@@ -99,6 +99,11 @@ class TreeSitterBase(AbstractParser):
         """Return ``('//',)`` — correct for C-family languages."""
         return ("//",)
 
+    @property
+    def block_comment(self) -> tuple[str, str]:
+        """Return ``('/*', '*/')`` — correct for C-family languages."""
+        return ("/*", "*/")
+
     # ── Subclass hooks ────────────────────────────────────────────────
 
     @property
@@ -7,6 +7,7 @@ from typing import TYPE_CHECKING
 
 from dev_stats.core.models import ClassReport, MethodReport
 from dev_stats.core.parsers.javascript_parser import (
+    _CONTROL_KEYWORDS,
     JavaScriptParser,
     _approx_cc,
     _parse_params,
@@ -230,7 +231,7 @@ class TypeScriptParser(JavaScriptParser):
 
         for match in _TS_METHOD_RE.finditer(body):
             name = match.group("name")
-            if name in seen:
+            if name in seen or name in _CONTROL_KEYWORDS:
                 continue
             seen.add(name)
 
@@ -0,0 +1,127 @@
+"""Differ comparing two analysis snapshots symbol by symbol."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from dev_stats.core.models import FileDiff, FunctionDiff, StatsDiff, SymbolDelta, SymbolMetrics
+
+if TYPE_CHECKING:
+    from dev_stats.core.models import FileReport, FunctionStats, RepoReport
+
+
+class ReportDiffer:
+    """Computes added, removed and changed functions between two reports.
+
+    Symbols are keyed by ``(file, qualified name)``, so a function moved to
+    another file shows up as one removal plus one addition.  A symbol is
+    *changed* when its LOC, cyclomatic or cognitive complexity differs.
+    """
+
+    def diff(self, before: RepoReport, after: RepoReport) -> StatsDiff:
+        """Compare *before* with *after*.
+
+        Args:
+            before: The earlier snapshot.
+            after: The later snapshot.
+
+        Returns:
+            A ``StatsDiff`` with each list sorted by file and symbol.
+        """
+        old = self._symbols(before)
+        new = self._symbols(after)
+
+        added = [SymbolDelta(f, s, after=new[f, s]) for f, s in sorted(new.keys() - old.keys())]
+        removed = [
+            SymbolDelta(f, s, before=old[f, s]) for f, s in sorted(old.keys() - new.keys())
+        ]
+        changed = [
+            SymbolDelta(f, s, before=old[f, s], after=new[f, s])
+            for f, s in sorted(old.keys() & new.keys())
+            if old[f, s] != new[f, s]
+        ]
+        return StatsDiff(added=tuple(added), removed=tuple(removed), changed=tuple(changed))
+
+    def diff_file(self, before: FileReport, after: FileReport) -> FileDiff:
+        """Compare two versions of one file function by function.
+
+        Functions are matched by qualified name.  One counts as changed
+        when its parameter or return count, cyclomatic or cognitive
+        complexity or line count differs; moving it within the file does
+        not.
+
+        Args:
+            before: The earlier version.
+            after: The later version.
+
+        Returns:
+            A ``FileDiff`` with each list sorted by name.
+        """
+        old = self._functions(before)
+        new = self._functions(after)
+        changed = [
+            FunctionDiff(name=name, before=old[name], after=new[name])
+            for name in sorted(old.keys() & new.keys())
+            if self._metrics(old[name]) != self._metrics(new[name])
+        ]
+        return FileDiff(
+            added=tuple(sorted(new.keys() - old.keys())),
+            removed=tuple(sorted(old.keys() - new.keys())),
+            changed=tuple(changed),
+        )
+
+    @staticmethod
+    def _functions(file: FileReport) -> dict[str, FunctionStats]:
+        """Index the functions of *file* by qualified name.
+
+        Args:
+            file: The file to index.
+
+        Returns:
+            ``{Type.method or name: stats}`` mapping.
+        """
+        return {
+            name: stats
+            for (name, _), stats in zip(file.qualified_functions, file.function_stats, strict=True)
+        }
+
+    @staticmethod
+    def _metrics(stats: FunctionStats) -> tuple[int, ...]:
+        """Return the metric values compared by :meth:`diff_file`.
+
+        Args:
+            stats: One function.
+
+        Returns:
+            Parameter and return counts, CC, cognitive complexity and lines.
+        """
+        return (
+            stats.param_count,
+            stats.return_count,
+            stats.cyclomatic_complexity,
+            stats.cognitive_complexity,
+            stats.lines,
+        )
+
+    @staticmethod
+    def _symbols(report: RepoReport) -> dict[tuple[str, str], SymbolMetrics]:
+        """Index every function and method in *report*.
+
+        Args:
+            report: The report to index.
+
+        Returns:
+            ``{(file, symbol): metrics}`` mapping.
+        """
+        result: dict[tuple[str, str], SymbolMetrics] = {}
+        for f in report.files:
+            path = str(f.path)
+            named = [(func.name, func) for func in f.functions]
+            named.extend((f"{cls.name}.{m.name}", m) for cls in f.classes for m in cls.methods)
+            for name, m in named:
+                result[path, name] = SymbolMetrics(
+                    lines=m.lines,
+                    cyclomatic_complexity=m.cyclomatic_complexity,
+                    cognitive_complexity=m.cognitive_complexity,
+                )
+        return result
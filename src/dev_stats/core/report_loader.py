@@ -0,0 +1,123 @@
+"""Loader that rebuilds a RepoReport from a saved full JSON export."""
+
+from __future__ import annotations
+
+import json
+from pathlib import Path
+from typing import Any
+
+from dev_stats.core.models import (
+    ClassReport,
+    ErrorHandlingReport,
+    FileReport,
+    HalsteadReport,
+    MethodReport,
+    ParameterReport,
+    RepoReport,
+)
+
+
+class ReportLoader:
+    """Reads ``dev-stats.json`` files written by ``JsonExporter`` (full mode).
+
+    Only the code-structure part of the report (root, files, classes,
+    methods) is restored; metrics and git sections are left at their
+    defaults.  Unknown keys are ignored so that newer exports still load.
+    """
+
+    def load(self, path: Path) -> RepoReport:
+        """Load a full JSON export from *path*.
+
+        Args:
+            path: Path to a ``dev-stats.json`` file.
+
+        Returns:
+            A ``RepoReport`` with ``root`` and ``files`` populated.
+
+        Raises:
+            FileNotFoundError: If *path* does not exist.
+            ValueError: If the file is not a full JSON export.
+        """
+        data = json.loads(path.read_text(encoding="utf-8"))
+        if not isinstance(data, dict) or "files" not in data:
+            msg = f"{path} is not a full dev-stats JSON export"
+            raise ValueError(msg)
+        return RepoReport(
+            root=Path(data.get("root", ".")),
+            files=tuple(self._file(f) for f in data["files"]),
+        )
+
+    def _file(self, data: dict[str, Any]) -> FileReport:
+        """Rebuild a ``FileReport``.
+
+        Args:
+            data: Serialised file report.
+
+        Returns:
+            The reconstructed ``FileReport``.
+        """
+        return FileReport(
+            path=Path(data["path"]),
+            language=data.get("language", ""),
+            total_lines=data.get("total_lines", 0),
+            code_lines=data.get("code_lines", 0),
+            blank_lines=data.get("blank_lines", 0),
+            comment_lines=data.get("comment_lines", 0),
+            size_bytes=data.get("size_bytes", 0),
+            classes=tuple(self._class(c) for c in data.get("classes", ())),
+            functions=tuple(self._method(m) for m in data.get("functions", ())),
+            imports=tuple(data.get("imports", ())),
+            package=data.get("package"),
+        )
+
+    def _class(self, data: dict[str, Any]) -> ClassReport:
+        """Rebuild a ``ClassReport``.
+
+        Args:
+            data: Serialised class report.
+
+        Returns:
+            The reconstructed ``ClassReport``.
+        """
+        return ClassReport(
+            name=data["name"],
+            line=data.get("line", 0),
+            end_line=data.get("end_line", 0),
+            lines=data.get("lines", 0),
+            methods=tuple(self._method(m) for m in data.get("methods", ())),
+            attributes=tuple(data.get("attributes", ())),
+            base_classes=tuple(data.get("base_classes", ())),
+            docstring=data.get("docstring"),
+            decorators=tuple(data.get("decorators", ())),
+            interface_methods=tuple(self._method(m) for m in data.get("interface_methods", ())),
+        )
+
+    @staticmethod
+    def _method(data: dict[str, Any]) -> MethodReport:
+        """Rebuild a ``MethodReport``.
+
+        Args:
+            data: Serialised method report.
+
+        Returns:
+            The reconstructed ``MethodReport``.
+        """
+        halstead = data.get("halstead")
+        errors = data.get("error_handling")
+        return MethodReport(
+            name=data["name"],
+            line=data.get("line", 0),
+            end_line=data.get("end_line", 0),
+            lines=data.get("lines", 0),
+            parameters=tuple(ParameterReport(**p) for p in data.get("parameters", ())),
+            cyclomatic_complexity=data.get("cyclomatic_complexity", 1),
+            cognitive_complexity=data.get("cognitive_complexity", 0),
+            nesting_depth=data.get("nesting_depth", 0),
+            is_constructor=data.get("is_constructor", False),
+            docstring=data.get("docstring"),
+            decorators=tuple(data.get("decorators", ())),
+            halstead=HalsteadReport(**halstead) if halstead else None,
+            return_count=data.get("return_count", 0),
+            body_hash=data.get("body_hash"),
+            error_handling=ErrorHandlingReport(**errors) if errors else None,
+        )
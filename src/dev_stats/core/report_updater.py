@@ -0,0 +1,88 @@
+"""Incremental updater that re-parses only changed files of a RepoReport."""
+
+from __future__ import annotations
+
+import dataclasses
+import logging
+from typing import TYPE_CHECKING
+
+from dev_stats.core.aggregator import Aggregator
+from dev_stats.core.dispatcher import PARSE_ERRORS
+
+if TYPE_CHECKING:
+    from collections.abc import Iterable
+    from pathlib import Path
+
+    from dev_stats.core.dispatcher import Dispatcher
+    from dev_stats.core.models import FileReport, RepoReport
+    from dev_stats.core.scanner import Scanner
+
+logger = logging.getLogger(__name__)
+
+
+class ReportUpdater:
+    """Keeps a :class:`RepoReport` current as files change on disk.
+
+    Changed files are re-parsed through the dispatcher (so a parse cache
+    is honoured), deleted files are dropped, and the code-structure
+    aggregates (modules, languages, coupling) are recomputed.  Git data
+    from the original report is carried over unchanged.  With a scanner,
+    files its filters reject (excludes, ``--lang``, tests, generated
+    code, non-recursive mode) are kept out of the report.
+    """
+
+    def __init__(
+        self, report: RepoReport, dispatcher: Dispatcher, scanner: Scanner | None = None
+    ) -> None:
+        """Initialise the updater.
+
+        Args:
+            report: The report from the initial full analysis.
+            dispatcher: Dispatcher used to re-parse changed files.
+            scanner: Scanner of the initial analysis, whose
+                :meth:`~Scanner.includes` check filters changed paths.
+        """
+        self._report = report
+        self._dispatcher = dispatcher
+        self._scanner = scanner
+        self._files: dict[Path, FileReport] = {f.path: f for f in report.files}
+
+    @property
+    def report(self) -> RepoReport:
+        """Return the current report."""
+        return self._report
+
+    def apply(self, changed: Iterable[Path]) -> RepoReport:
+        """Re-parse *changed* files and rebuild the report.
+
+        Args:
+            changed: Absolute or repository-relative paths reported by the
+                watcher.  Paths outside the repository are ignored.
+
+        Returns:
+            The updated report (also available as :attr:`report`).
+        """
+        root = self._report.root
+        for path in changed:
+            if path.is_absolute() and not path.is_relative_to(root):
+                continue
+            relative = path.relative_to(root) if path.is_absolute() else path
+            if not (root / relative).is_file() or (
+                self._scanner is not None and not self._scanner.includes(relative)
+            ):
+                self._files.pop(relative, None)
+                continue
+            try:
+                self._files[relative] = self._dispatcher.parse(relative)
+            except PARSE_ERRORS:
+                logger.exception("Failed to parse %s", relative)
+
+        fresh = Aggregator().aggregate(files=list(self._files.values()), repo_root=root)
+        self._report = dataclasses.replace(
+            self._report,
+            files=fresh.files,
+            modules=fresh.modules,
+            languages=fresh.languages,
+            coupling=fresh.coupling,
+        )
+        return self._report
@@ -4,6 +4,7 @@ from __future__ import annotations
 
 import fnmatch
 import logging
+import re
 from dataclasses import dataclass
 from typing import TYPE_CHECKING, Protocol, runtime_checkable
 
@@ -114,6 +115,24 @@ _BINARY_EXTENSIONS: frozenset[str] = frozenset(
     }
 )
 
+# Test-file name patterns skipped when ``config.exclude_tests`` is set.
+_TEST_FILE_PATTERNS: tuple[str, ...] = (
+    "*_test.go",
+    "test_*.py",
+    "*_test.py",
+    "*.test.js",
+    "*.test.ts",
+    "*.spec.js",
+    "*.spec.ts",
+    "*Test.java",
+    "*Tests.cs",
+)
+
+# Generated-file marker (https://go.dev/s/generatedcode), also used by
+# other generators with ``#`` comments.
+_GENERATED_RE = re.compile(r"^\s*(?://|#)\s*Code generated\b")
+_GENERATED_HEADER_LINES = 10
+
 
 @dataclass(frozen=True)
 class ProgressEvent:
@@ -145,10 +164,17 @@ class Scanner:
     """Traverse a repository tree yielding source-file paths.
 
     Respects exclude patterns from configuration, ``.gitignore``, and
-    hard-coded exclusions (``.git``, ``__pycache__``, ``*.pyc``).
+    hard-coded exclusions (``.git``, ``__pycache__``, ``*.pyc``).  Test
+    and generated files are skipped when ``config.exclude_tests`` or
+    ``config.exclude_generated`` is set, and files with other suffixes
+    when *extensions* is given.  :meth:`includes` applies the same rules
+    to a single path, e.g. one reported by a file watcher.
 
     The scanner is lazy: :meth:`scan` is a generator that yields paths
     one at a time without loading the full file list into memory.
+
+    Symlinked directories are never descended into, so symlink loops
+    cannot cause infinite recursion.
     """
 
     def __init__(
@@ -156,6 +182,7 @@ class Scanner:
         repo_path: Path,
         config: AnalysisConfig,
         observers: list[ProgressObserver] | None = None,
+        extensions: frozenset[str] | None = None,
     ) -> None:
         """Initialise the scanner.
 
@@ -163,6 +190,8 @@ class Scanner:
             repo_path: Root directory to scan.
             config: Analysis configuration (provides exclude patterns).
             observers: Optional progress observers to notify.
+            extensions: Only yield files with these suffixes (e.g. the
+                extensions of the ``--lang`` languages); ``None`` keeps all.
 
         Raises:
             FileNotFoundError: If *repo_path* does not exist.
@@ -173,6 +202,7 @@ class Scanner:
         self._repo_path = repo_path.resolve()
         self._config = config
         self._observers: list[ProgressObserver] = observers or []
+        self._extensions = extensions
         self._exclude_patterns = (
             *_ALWAYS_EXCLUDED,
             *config.exclude_patterns,
@@ -182,20 +212,51 @@ class Scanner:
     def scan(self) -> Generator[Path, None, None]:
         """Yield repository-relative paths for all non-excluded files.
 
+        Only the top-level directory is visited when
+        ``config.recursive`` is ``False``.
+
         Yields:
             Paths relative to the repository root.
         """
         count = 0
-        for path in self._repo_path.rglob("*"):
+        candidates = (
+            self._repo_path.rglob("*") if self._config.recursive else self._repo_path.iterdir()
+        )
+        for path in candidates:
+            # ``rglob`` does not follow directory symlinks; skip them here too.
             if path.is_dir():
                 continue
             relative = path.relative_to(self._repo_path)
-            if self._is_excluded(relative):
+            if not self.includes(relative):
                 continue
             count += 1
             self._emit_progress(count, relative)
             yield relative
 
+    def includes(self, relative: Path) -> bool:
+        """Check whether :meth:`scan` would yield *relative*.
+
+        The file itself is only read for the generated-code check; missing
+        files are not rejected for that reason.
+
+        Args:
+            relative: Repository-relative path of a file.
+
+        Returns:
+            ``True`` if the path passes every configured filter.
+        """
+        if not self._config.recursive and len(relative.parts) > 1:
+            return False
+        if self._extensions is not None and relative.suffix not in self._extensions:
+            return False
+        if self._is_excluded(relative):
+            return False
+        if self._config.exclude_tests and self._is_test_file(relative):
+            return False
+        return not (
+            self._config.exclude_generated and self._is_generated(self._repo_path / relative)
+        )
+
     def _is_excluded(self, path: Path) -> bool:
         """Check whether *path* matches any exclude pattern.
 
@@ -221,6 +282,42 @@ class Scanner:
                     return True
         return False
 
+    @staticmethod
+    def _is_test_file(path: Path) -> bool:
+        """Check whether *path* names a test file.
+
+        Args:
+            path: Repository-relative path to check.
+
+        Returns:
+            ``True`` if the file name matches a known test-file pattern.
+        """
+        return any(fnmatch.fnmatchcase(path.name, p) for p in _TEST_FILE_PATTERNS)
+
+    @staticmethod
+    def _is_generated(path: Path) -> bool:
+        """Check whether *path* carries a ``Code generated`` header.
+
+        Only the first few lines are read.
+
+        Args:
+            path: Absolute path to the file.
+
+        Returns:
+            ``True`` if a generated-code marker is found.
+        """
+        try:
+            with path.open(encoding="utf-8", errors="replace") as fh:
+                for _ in range(_GENERATED_HEADER_LINES):
+                    line = fh.readline()
+                    if not line:
+                        break
+                    if _GENERATED_RE.match(line):
+                        return True
+        except OSError:
+            logger.warning("Could not read %s", path)
+        return False
+
     def _parse_gitignore(self) -> list[str]:
         """Read ``.gitignore`` from the repo root and return patterns.
 
@@ -0,0 +1,269 @@
+"""Versioned on-disk snapshots of a complete RepoReport."""
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+import json
+import struct
+import types
+import typing
+import zlib
+from datetime import datetime
+from pathlib import Path
+from typing import Any, cast
+
+from dev_stats.core import models
+from dev_stats.core.models import RepoReport
+
+SNAPSHOT_MAGIC = 0x44535354  # "DSST"
+"""Leading 32-bit big-endian magic number of binary snapshots."""
+
+SNAPSHOT_VERSION = 1
+"""Format version; bump when a stored field is renamed or removed."""
+
+_HEADER = struct.Struct(">IB")
+
+# Names that models.py only imports for type checking.
+_TYPE_NAMESPACE = {"Path": Path, "datetime": datetime}
+
+
+class SnapshotVersionError(ValueError):
+    """Raised when a snapshot's header or version is not understood."""
+
+
+class SnapshotStore:
+    """Saves and loads every field of a :class:`RepoReport`.
+
+    Unlike a ``dev-stats.json`` export read back by ``ReportLoader``, a
+    snapshot round-trips the whole report, git and metrics sections
+    included.  Two encodings are offered:
+
+    * **binary** (:meth:`save` / :meth:`load`) -- a 5-byte header
+      (:data:`SNAPSHOT_MAGIC` as ``uint32`` plus a version byte) followed
+      by zlib-compressed JSON.
+    * **JSON** (:meth:`save_json` / :meth:`load_json`) -- readable
+      ``{"snapshot_version": ..., "report": ...}``.
+
+    Both raise :class:`SnapshotVersionError` for a foreign or newer file.
+    """
+
+    def save(self, path: Path, report: RepoReport) -> None:
+        """Write a binary snapshot.
+
+        Args:
+            path: Destination file; parent directories are created.
+            report: The report to save.
+        """
+        payload = json.dumps(self.encode(report), separators=(",", ":")).encode("utf-8")
+        path.parent.mkdir(parents=True, exist_ok=True)
+        path.write_bytes(_HEADER.pack(SNAPSHOT_MAGIC, SNAPSHOT_VERSION) + zlib.compress(payload))
+
+    def load(self, path: Path) -> RepoReport:
+        """Read a binary snapshot.
+
+        Args:
+            path: Snapshot written by :meth:`save`.
+
+        Returns:
+            The restored report.
+
+        Raises:
+            FileNotFoundError: If *path* does not exist.
+            SnapshotVersionError: If the header is missing, the magic number
+                is wrong or the version is unsupported.
+            ValueError: If the payload is corrupt.
+        """
+        data = path.read_bytes()
+        if len(data) < _HEADER.size:
+            msg = f"{path}: too short to be a dev-stats snapshot"
+            raise SnapshotVersionError(msg)
+        magic, version = _HEADER.unpack_from(data)
+        if magic != SNAPSHOT_MAGIC:
+            msg = f"{path}: not a dev-stats snapshot (magic {magic:#010x})"
+            raise SnapshotVersionError(msg)
+        self._check_version(path, version)
+        try:
+            payload = zlib.decompress(data[_HEADER.size :])
+        except zlib.error as exc:
+            msg = f"{path}: corrupt snapshot payload: {exc}"
+            raise ValueError(msg) from exc
+        return self.decode(json.loads(payload))
+
+    def save_json(self, path: Path, report: RepoReport) -> None:
+        """Write a JSON snapshot.
+
+        Args:
+            path: Destination file; parent directories are created.
+            report: The report to save.
+        """
+        document = {"snapshot_version": SNAPSHOT_VERSION, "report": self.encode(report)}
+        path.parent.mkdir(parents=True, exist_ok=True)
+        path.write_text(json.dumps(document, indent=2, ensure_ascii=False) + "\n", "utf-8")
+
+    def load_json(self, path: Path) -> RepoReport:
+        """Read a JSON snapshot.
+
+        Args:
+            path: Snapshot written by :meth:`save_json`.
+
+        Returns:
+            The restored report.
+
+        Raises:
+            FileNotFoundError: If *path* does not exist.
+            SnapshotVersionError: If the document carries no supported
+                ``snapshot_version``.
+            ValueError: If the report data is malformed.
+        """
+        document = json.loads(path.read_text(encoding="utf-8"))
+        if not isinstance(document, dict) or "report" not in document:
+            msg = f"{path}: not a dev-stats JSON snapshot"
+            raise SnapshotVersionError(msg)
+        self._check_version(path, document.get("snapshot_version"))
+        return self.decode(document["report"])
+
+    @staticmethod
+    def is_binary(path: Path) -> bool:
+        """Return whether *path* starts with the binary snapshot magic number.
+
+        Args:
+            path: File to inspect.
+
+        Returns:
+            ``True`` for a binary snapshot, ``False`` otherwise.
+        """
+        with path.open("rb") as stream:
+            head = stream.read(_HEADER.size)
+        return len(head) == _HEADER.size and _HEADER.unpack(head)[0] == SNAPSHOT_MAGIC
+
+    @classmethod
+    def encode(cls, report: RepoReport) -> dict[str, Any]:
+        """Convert *report* to JSON-compatible data.
+
+        Args:
+            report: The report to encode.
+
+        Returns:
+            Nested dictionaries, lists and scalars.
+        """
+        return cast("dict[str, Any]", cls._encode(report))
+
+    @classmethod
+    def decode(cls, data: dict[str, Any]) -> RepoReport:
+        """Rebuild a report from :meth:`encode` output.
+
+        Args:
+            data: Encoded report.
+
+        Returns:
+            The restored report.
+        """
+        return cast("RepoReport", cls._decode(RepoReport, data))
+
+    @staticmethod
+    def _check_version(path: Path, version: object) -> None:
+        """Reject snapshots written by an incompatible format version.
+
+        Args:
+            path: Snapshot path for the error message.
+            version: Version read from the snapshot.
+
+        Raises:
+            SnapshotVersionError: Unless *version* is :data:`SNAPSHOT_VERSION`.
+        """
+        if version != SNAPSHOT_VERSION:
+            msg = (
+                f"{path}: unsupported snapshot version {version!r} "
+                f"(this dev-stats reads version {SNAPSHOT_VERSION})"
+            )
+            raise SnapshotVersionError(msg)
+
+    @classmethod
+    def _encode(cls, value: object) -> object:
+        """Encode one value.
+
+        Args:
+            value: A dataclass, tuple, enum, path, datetime or scalar.
+
+        Returns:
+            JSON-compatible value.
+        """
+        if dataclasses.is_dataclass(value) and not isinstance(value, type):
+            return {f.name: cls._encode(getattr(value, f.name)) for f in dataclasses.fields(value)}
+        if isinstance(value, tuple):
+            return [cls._encode(item) for item in value]
+        if isinstance(value, enum.Enum):
+            return value.value
+        if isinstance(value, Path):
+            return value.as_posix()
+        if isinstance(value, datetime):
+            return value.isoformat()
+        return value
+
+    @classmethod
+    def _decode(cls, hint: object, value: object) -> object:
+        """Decode *value* into the type described by *hint*.
+
+        Args:
+            hint: ``X | None``, ``tuple[X, ...]``, fixed tuples, a model
+                dataclass, an enum, ``Path``, ``datetime`` or a scalar type.
+            value: Encoded value.
+
+        Returns:
+            The decoded value.
+
+        Raises:
+            ValueError: If *value* does not have the JSON shape of *hint*.
+        """
+        origin = typing.get_origin(hint)
+        if origin is types.UnionType or origin is typing.Union:
+            if value is None:
+                return None
+            (inner,) = (arg for arg in typing.get_args(hint) if arg is not type(None))
+            return cls._decode(inner, value)
+        if origin is tuple:
+            args = typing.get_args(hint)
+            items = cls._expect(list, hint, value)
+            if len(args) == 2 and args[1] is Ellipsis:
+                return tuple(cls._decode(args[0], item) for item in items)
+            return tuple(cls._decode(arg, item) for arg, item in zip(args, items, strict=True))
+        if isinstance(hint, type) and dataclasses.is_dataclass(hint):
+            fields = cls._expect(dict, hint, value)
+            hints = typing.get_type_hints(hint, vars(models), _TYPE_NAMESPACE)
+            return hint(
+                **{
+                    f.name: cls._decode(hints[f.name], fields[f.name])
+                    for f in dataclasses.fields(hint)
+                    if f.name in fields
+                }
+            )
+        if isinstance(hint, type) and issubclass(hint, enum.Enum):
+            return hint(value)
+        if hint is Path:
+            return Path(cls._expect(str, hint, value))
+        if hint is datetime:
+            return datetime.fromisoformat(cls._expect(str, hint, value))
+        if hint is float:
+            return float(cls._expect((int, float), hint, value))
+        return value
+
+    @staticmethod
+    def _expect[T](kind: type[T] | tuple[type[T], ...], hint: object, value: object) -> T:
+        """Return *value* after checking it is an instance of *kind*.
+
+        Args:
+            kind: Expected JSON type(s).
+            hint: Type being decoded, for the error message.
+            value: Encoded value.
+
+        Returns:
+            *value*, narrowed to *kind*.
+
+        Raises:
+            ValueError: If *value* is not an instance of *kind*.
+        """
+        if not isinstance(value, kind):
+            msg = f"corrupt snapshot: expected {hint} data, got {type(value).__name__}"
+            raise ValueError(msg)
+        return value
@@ -0,0 +1,146 @@
+"""Annotator writing inline metric comments onto Go function signatures."""
+
+from __future__ import annotations
+
+import re
+from bisect import bisect_right
+from typing import TYPE_CHECKING
+
+from dev_stats.core.parsers.go_parser import noise_spans
+
+if TYPE_CHECKING:
+    from collections.abc import Iterable
+
+    from dev_stats.core.models import FileReport
+
+MARKER = "// devstats:"
+"""Prefix of every comment the annotator writes."""
+
+_ANNOTATION_RE = re.compile(r" // devstats:[^\r\n]*(?=\r?\n|\Z)")
+_FUNC_RE = re.compile(r"\bfunc\b")
+
+
+class SourceAnnotator:
+    """Adds or strips ``// devstats: cc=N loc=M`` comments in Go source.
+
+    The comment goes at the end of the line holding each function's
+    ``func`` keyword, after a single space.  Only those lines are touched:
+    indentation, line endings and every other byte stay as they are, so
+    :meth:`unannotate` restores the original text exactly.  Re-annotating
+    replaces stale comments instead of stacking new ones.
+
+    Comments and string literals are located with the Go parser's
+    scanner: a ``func`` inside a raw string is never annotated, a line
+    ending inside a literal is never appended to, and ``// devstats:``
+    text inside a string is never stripped.
+    """
+
+    def annotate(self, source: str, file: FileReport) -> str:
+        """Return *source* with a metric comment on every function signature.
+
+        Args:
+            source: Go source text that *file* was parsed from.
+            file: The file's report, supplying lines and metrics.
+
+        Returns:
+            The annotated source.
+
+        Raises:
+            ValueError: If *file* is not a Go file, or a function starts
+                past the end of *source* or on a line that ends inside a
+                string literal or block comment.
+        """
+        if file.language != "go":
+            msg = f"{file.path}: only Go files can be annotated, not {file.language}"
+            raise ValueError(msg)
+        spans = noise_spans(source)
+        comments = _comments(source, spans)
+        lines = source.splitlines(keepends=True)
+        offsets = [0]
+        for raw in lines:
+            offsets.append(offsets[-1] + len(raw))
+        for _, func in file.qualified_functions:
+            index = func.line - 1
+            if not 0 <= index < len(lines):
+                msg = f"{file.path}: {func.name} starts at line {func.line}, past the end"
+                raise ValueError(msg)
+            raw = lines[index]
+            body = raw.rstrip("\r\n")
+            ending = raw[len(body) :]
+            start = offsets[index]
+            if not _in_code(spans, _FUNC_RE.finditer(source, start, start + len(body))):
+                # A ``func`` the regex parser found inside a literal.
+                continue
+            tail = _enclosing(spans, start + len(body))
+            if tail is not None and not source.startswith("//", tail[0]):
+                msg = f"{file.path}: line {func.line} of {func.name} ends inside a literal"
+                raise ValueError(msg)
+            stripped = _strip_annotations(body, start, comments)
+            comment = f"{MARKER} cc={func.cyclomatic_complexity} loc={func.lines}"
+            lines[index] = f"{stripped} {comment}{ending}"
+        return "".join(lines)
+
+    @staticmethod
+    def unannotate(source: str) -> str:
+        """Strip every ``// devstats:`` comment written by :meth:`annotate`.
+
+        Args:
+            source: Annotated source text.
+
+        Returns:
+            The source without metric comments.
+        """
+        return _strip_annotations(source, 0, _comments(source, noise_spans(source)))
+
+
+def _enclosing(spans: list[tuple[int, int]], pos: int) -> tuple[int, int] | None:
+    """Return the span of *spans* containing offset *pos*, if any.
+
+    Args:
+        spans: Sorted, non-overlapping ``(start, end)`` offsets.
+        pos: Offset to look up.
+
+    Returns:
+        The containing span, or ``None``.
+    """
+    index = bisect_right(spans, pos, key=lambda span: span[0]) - 1
+    if index >= 0 and pos < spans[index][1]:
+        return spans[index]
+    return None
+
+
+def _in_code(spans: list[tuple[int, int]], matches: Iterable[re.Match[str]]) -> bool:
+    """Return whether any of *matches* lies outside every literal and comment.
+
+    Args:
+        spans: Output of :func:`noise_spans`.
+        matches: Keyword matches in the same source.
+
+    Returns:
+        ``True`` if at least one match is real code.
+    """
+    return any(_enclosing(spans, m.start()) is None for m in matches)
+
+
+def _strip_annotations(text: str, offset: int, comments: list[tuple[int, int]]) -> str:
+    """Return *text* without the metric comments in it.
+
+    Only ``// devstats:`` text inside a real comment is removed, never
+    text inside a string literal.
+
+    Args:
+        text: A slice of Go source.
+        offset: Offset of *text* in the source.
+        comments: Comment spans of the source.
+
+    Returns:
+        The rewritten text.
+    """
+    return _ANNOTATION_RE.sub(
+        lambda m: "" if _enclosing(comments, offset + m.start() + 1) else m.group(), text
+    )
+
+
+def _comments(source: str, spans: list[tuple[int, int]]) -> list[tuple[int, int]]:
+    """Return the comment spans among *spans*."""
+    return [span for span in spans if source[span[0]] == "/"]
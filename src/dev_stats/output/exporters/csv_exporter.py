@@ -12,7 +12,7 @@ if TYPE_CHECKING:
     from pathlib import Path
 
     from dev_stats.config.analysis_config import AnalysisConfig
-    from dev_stats.core.models import RepoReport
+    from dev_stats.core.models import MethodReport, RepoReport
 
 
 class CsvExporter(AbstractExporter):
@@ -22,7 +22,7 @@ class CsvExporter(AbstractExporter):
 
     * ``files.csv`` — per-file line counts and structure counts.
     * ``classes.csv`` — per-class details.
-    * ``methods.csv`` — per-method/function details.
+    * ``methods.csv`` — per-method/function details, sorted by file and symbol.
     * ``languages.csv`` — per-language breakdown.
     """
 
@@ -138,6 +138,10 @@ class CsvExporter(AbstractExporter):
     def _write_methods_csv(self, output_dir: Path) -> Path:
         """Write methods.csv.
 
+        One row per function or method, sorted by file path and then by
+        qualified symbol name (``Class.method`` for methods) so that output
+        is stable across runs.
+
         Args:
             output_dir: Output directory.
 
@@ -156,16 +160,24 @@ class CsvExporter(AbstractExporter):
             "cognitive_complexity",
             "nesting_depth",
             "is_constructor",
+            "package",
+            "kind",
         ]
 
-        rows: list[list[str]] = []
+        keyed: list[tuple[str, str, list[str]]] = []
         for f in self._report.files:
-            for cls in f.classes:
-                for m in cls.methods:
-                    rows.append(
+            symbols: list[tuple[str, MethodReport]] = [
+                (cls.name, m) for cls in f.classes for m in cls.methods
+            ]
+            symbols.extend(("", func) for func in f.functions)
+            for owner, m in symbols:
+                keyed.append(
+                    (
+                        str(f.path),
+                        f"{owner}.{m.name}" if owner else m.name,
                         [
                             str(f.path),
-                            cls.name,
+                            owner,
                             m.name,
                             str(m.line),
                             str(m.end_line),
@@ -175,25 +187,13 @@ class CsvExporter(AbstractExporter):
                             str(m.cognitive_complexity),
                             str(m.nesting_depth),
                             str(m.is_constructor),
-                        ]
+                            f.package or "",
+                            "method" if owner else "function",
+                        ],
                     )
-            for func in f.functions:
-                rows.append(
-                    [
-                        str(f.path),
-                        "",
-                        func.name,
-                        str(func.line),
-                        str(func.end_line),
-                        str(func.lines),
-                        str(func.num_parameters),
-                        str(func.cyclomatic_complexity),
-                        str(func.cognitive_complexity),
-                        str(func.nesting_depth),
-                        str(func.is_constructor),
-                    ]
                 )
 
+        rows = [row for _, _, row in sorted(keyed, key=lambda k: (k[0], k[1]))]
         return self._write_csv(output_dir / "methods.csv", headers, rows)
 
     def _write_languages_csv(self, output_dir: Path) -> Path:
@@ -0,0 +1,175 @@
+"""HTML exporter producing a static, self-contained report."""
+
+from __future__ import annotations
+
+from html import escape
+from typing import TYPE_CHECKING
+
+from dev_stats.output.exporters.abstract_exporter import AbstractExporter
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+    from dev_stats.config.analysis_config import AnalysisConfig
+    from dev_stats.core.models import FileReport, MethodReport, RepoReport
+
+_CSS = """\
+body { font-family: system-ui, sans-serif; margin: 2rem; color: #222; }
+h1 { font-size: 1.5rem; }
+table { border-collapse: collapse; margin: 0.5rem 0 1rem; }
+th, td { border: 1px solid #ccc; padding: 0.25rem 0.6rem; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+th { background: #f3f3f3; }
+details { margin: 0.25rem 0; }
+summary { cursor: pointer; font-family: monospace; }
+.cc-low { background: #d4f7d4; }
+.cc-medium { background: #fff3b0; }
+.cc-high { background: #ffc9c9; }
+"""
+
+
+class HtmlExporter(AbstractExporter):
+    """Exports the analysis report as a single static HTML page.
+
+    Unlike the interactive dashboard, the page has no JavaScript and no
+    external assets, so it can be mailed or attached to a ticket as-is.
+    A summary table comes first, followed by one collapsible
+    ``<details>`` section per file with a function table (LOC, CC,
+    cognitive complexity).
+
+    CC cells are colour-coded: red above
+    ``thresholds.max_cyclomatic_complexity``, yellow from half that
+    limit, green otherwise.
+    """
+
+    def __init__(
+        self,
+        report: RepoReport,
+        config: AnalysisConfig,
+    ) -> None:
+        """Initialise the HTML exporter.
+
+        Args:
+            report: The analysis report to export.
+            config: Analysis configuration.
+        """
+        super().__init__(report, config)
+
+    def export(self, output_dir: Path) -> list[Path]:
+        """Write the HTML report to *output_dir*.
+
+        Args:
+            output_dir: Directory to write the HTML file into.
+
+        Returns:
+            Single-element list with the path to the generated file.
+        """
+        output_dir.mkdir(parents=True, exist_ok=True)
+        out_path = output_dir / "dev-stats-report.html"
+        out_path.write_text(self.render(), encoding="utf-8")
+        return [out_path]
+
+    def render(self) -> str:
+        """Render the full HTML document.
+
+        Returns:
+            The HTML page as a string.
+        """
+        parts = [
+            "<!DOCTYPE html>",
+            '<html lang="en">',
+            "<head>",
+            '<meta charset="utf-8">',
+            f"<title>dev-stats report — {escape(self._report.root.name)}</title>",
+            f"<style>\n{_CSS}</style>",
+            "</head>",
+            "<body>",
+            f"<h1>dev-stats report — {escape(str(self._report.root))}</h1>",
+            self._render_summary(),
+            "<h2>Files</h2>",
+        ]
+        parts.extend(self._render_file(f) for f in self._report.files)
+        parts.extend(["</body>", "</html>", ""])
+        return "\n".join(parts)
+
+    def _render_summary(self) -> str:
+        """Render the repository summary table.
+
+        Returns:
+            HTML ``<table>`` markup.
+        """
+        files = self._report.files
+        headers = ("Files", "Lines", "Code", "Comments", "Blank", "Classes", "Functions")
+        values = (
+            len(files),
+            sum(f.total_lines for f in files),
+            sum(f.code_lines for f in files),
+            sum(f.comment_lines for f in files),
+            sum(f.blank_lines for f in files),
+            sum(f.num_classes for f in files),
+            sum(f.num_functions + sum(c.num_methods for c in f.classes) for f in files),
+        )
+        head = "".join(f"<th>{h}</th>" for h in headers)
+        row = "".join(f"<td>{v}</td>" for v in values)
+        return (
+            '<table class="summary">'
+            f"<thead><tr>{head}</tr></thead>"
+            f"<tbody><tr>{row}</tr></tbody>"
+            "</table>"
+        )
+
+    def _render_file(self, file_report: FileReport) -> str:
+        """Render one collapsible per-file section.
+
+        Args:
+            file_report: The file to render.
+
+        Returns:
+            HTML ``<details>`` markup.
+        """
+        functions: list[tuple[str, MethodReport]] = [(f.name, f) for f in file_report.functions]
+        for cls in file_report.classes:
+            functions.extend((f"{cls.name}.{m.name}", m) for m in cls.methods)
+
+        summary = (
+            f"{escape(str(file_report.path))} — {file_report.total_lines} lines, "
+            f"{len(functions)} function(s)"
+        )
+        if not functions:
+            return f"<details><summary>{summary}</summary><p>No functions.</p></details>"
+
+        rows = "".join(
+            "<tr>"
+            f"<td>{escape(name)}</td>"
+            f"<td>{func.line}</td>"
+            f"<td>{func.lines}</td>"
+            f'<td class="{self._cc_class(func.cyclomatic_complexity)}">'
+            f"{func.cyclomatic_complexity}</td>"
+            f"<td>{func.cognitive_complexity}</td>"
+            "</tr>"
+            for name, func in functions
+        )
+        return (
+            f"<details><summary>{summary}</summary>"
+            '<table class="functions">'
+            "<thead><tr><th>Function</th><th>Line</th><th>LOC</th>"
+            "<th>CC</th><th>Cognitive</th></tr></thead>"
+            f"<tbody>{rows}</tbody>"
+            "</table></details>"
+        )
+
+    def _cc_class(self, cc: int) -> str:
+        """Return the CSS class for a cyclomatic-complexity value.
+
+        Args:
+            cc: Cyclomatic complexity.
+
+        Returns:
+            ``cc-high``, ``cc-medium``, or ``cc-low``.
+        """
+        limit = self._config.thresholds.max_cyclomatic_complexity
+        if cc > limit:
+            return "cc-high"
+        if cc >= limit // 2:
+            return "cc-medium"
+        return "cc-low"
@@ -25,9 +25,13 @@ class JsonExporter(AbstractExporter):
       classes, methods, and per-file details.
     * **summary** — Top-level statistics only (counts, language breakdown).
 
-    Dates are formatted as ISO 8601 strings.
+    Dates are formatted as ISO 8601 strings.  Both modes carry a top-level
+    ``schema_version`` key that is bumped whenever a field is renamed or
+    removed, so downstream consumers can detect breaking changes.
     """
 
+    SCHEMA_VERSION: int = 1
+
     def __init__(
         self,
         report: RepoReport,
@@ -75,7 +79,7 @@ class JsonExporter(AbstractExporter):
         Returns:
             Dictionary ready for JSON serialisation.
         """
-        return self._dataclass_to_dict(self._report)
+        return {"schema_version": self.SCHEMA_VERSION, **self._dataclass_to_dict(self._report)}
 
     def _build_summary(self) -> dict[str, object]:
         """Build the summary JSON payload.
@@ -94,6 +98,7 @@ class JsonExporter(AbstractExporter):
         total_methods = sum(len(c.methods) for f in rpt.files for c in f.classes)
 
         summary: dict[str, object] = {
+            "schema_version": self.SCHEMA_VERSION,
             "root": str(rpt.root),
             "files": total_files,
             "total_lines": total_lines,
@@ -112,6 +117,13 @@ class JsonExporter(AbstractExporter):
             summary["coverage_ratio"] = rpt.coverage.overall_ratio
         if rpt.coupling is not None:
             summary["coupling_modules"] = len(rpt.coupling.modules)
+        if rpt.go_module is not None:
+            summary["go_module"] = {
+                "path": rpt.go_module.module_path,
+                "go_version": rpt.go_module.go_version,
+                "direct_dependencies": rpt.go_module.num_direct_dependencies,
+                "indirect_dependencies": rpt.go_module.num_indirect_dependencies,
+            }
 
         return summary
 
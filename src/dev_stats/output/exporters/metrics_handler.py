@@ -0,0 +1,56 @@
+"""HTTP request handler serving Prometheus metrics at ``/metrics``."""
+
+from __future__ import annotations
+
+import logging
+from http import HTTPStatus
+from http.server import BaseHTTPRequestHandler
+from typing import TYPE_CHECKING, Any
+
+from dev_stats.output.exporters.prometheus_exporter import CONTENT_TYPE, render_metrics
+
+if TYPE_CHECKING:
+    from collections.abc import Callable
+
+    from dev_stats.core.models import RepoReport
+
+logger = logging.getLogger(__name__)
+
+
+class MetricsHandler(BaseHTTPRequestHandler):
+    """Serves :func:`render_metrics` for the current report.
+
+    *report_provider* is called on every scrape, so it can hand out a
+    report that is kept current elsewhere (e.g. by watch mode).  Bind it
+    with :func:`functools.partial` before passing the class to
+    :class:`http.server.HTTPServer`; paths other than ``/metrics`` get 404.
+    """
+
+    def __init__(
+        self, report_provider: Callable[[], RepoReport], *args: Any, **kwargs: Any
+    ) -> None:
+        """Store the provider, then let the base class handle the request.
+
+        Args:
+            report_provider: Zero-argument callable returning the report.
+            *args: Positional arguments for ``BaseHTTPRequestHandler``.
+            **kwargs: Keyword arguments for ``BaseHTTPRequestHandler``.
+        """
+        self._report_provider = report_provider
+        super().__init__(*args, **kwargs)
+
+    def do_GET(self) -> None:  # noqa: N802
+        """Answer ``GET /metrics``."""
+        if self.path.split("?", 1)[0] != "/metrics":
+            self.send_error(HTTPStatus.NOT_FOUND)
+            return
+        body = render_metrics(self._report_provider()).encode("utf-8")
+        self.send_response(HTTPStatus.OK)
+        self.send_header("Content-Type", CONTENT_TYPE)
+        self.send_header("Content-Length", str(len(body)))
+        self.end_headers()
+        self.wfile.write(body)
+
+    def log_message(self, format: str, *args: object) -> None:  # noqa: A002
+        """Route access logs to the module logger instead of stderr."""
+        logger.debug(format, *args)
@@ -0,0 +1,119 @@
+"""Prometheus text-format exporter."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from dev_stats.output.exporters.abstract_exporter import AbstractExporter
+
+if TYPE_CHECKING:
+    from collections.abc import Callable
+    from pathlib import Path
+
+    from dev_stats.core.models import FileReport, RepoReport
+
+CONTENT_TYPE = "text/plain; version=0.0.4; charset=utf-8"
+
+# (metric name, help text, per-file value)
+_GAUGES: tuple[tuple[str, str, Callable[[FileReport], float]], ...] = (
+    (
+        "dev_stats_functions",
+        "Functions and methods in the file.",
+        lambda f: len(f.qualified_functions),
+    ),
+    (
+        "dev_stats_cyclomatic_complexity_avg",
+        "Mean cyclomatic complexity of the file's functions.",
+        lambda f: (
+            sum(fn.cyclomatic_complexity for _, fn in f.qualified_functions)
+            / len(f.qualified_functions)
+            if f.qualified_functions
+            else 0.0
+        ),
+    ),
+    (
+        "dev_stats_cyclomatic_complexity_max",
+        "Highest cyclomatic complexity among the file's functions.",
+        lambda f: max((fn.cyclomatic_complexity for _, fn in f.qualified_functions), default=0),
+    ),
+    (
+        "dev_stats_lines",
+        "Total lines in the file.",
+        lambda f: f.total_lines,
+    ),
+    (
+        "dev_stats_undocumented_exports",
+        "Exported types, functions and methods without a doc comment.",
+        lambda f: len(f.undocumented_exports),
+    ),
+)
+
+
+def _escape(value: str) -> str:
+    r"""Escape a label value for the text exposition format.
+
+    Args:
+        value: Raw label value.
+
+    Returns:
+        Value with ``\``, ``"`` and newlines escaped.
+    """
+    return value.replace("\\", "\\\\").replace('"', '\\"').replace("\n", "\\n")
+
+
+def _format(value: float) -> str:
+    """Format a sample value, dropping ``.0`` from whole numbers.
+
+    Args:
+        value: Sample value.
+
+    Returns:
+        Text representation.
+    """
+    return str(int(value)) if float(value).is_integer() else repr(float(value))
+
+
+def render_metrics(report: RepoReport) -> str:
+    """Render per-file gauges in the Prometheus text exposition format.
+
+    Every sample carries ``package`` (empty for languages without
+    packages) and ``file`` (repository-relative POSIX path) labels.
+
+    Args:
+        report: The analysis report.
+
+    Returns:
+        The exposition text, ending in a newline.
+    """
+    lines: list[str] = []
+    for name, help_text, value in _GAUGES:
+        lines.append(f"# HELP {name} {help_text}")
+        lines.append(f"# TYPE {name} gauge")
+        for f in report.files:
+            labels = f'package="{_escape(f.package or "")}",file="{_escape(f.path.as_posix())}"'
+            lines.append(f"{name}{{{labels}}} {_format(value(f))}")
+    return "\n".join(lines) + "\n"
+
+
+class PrometheusExporter(AbstractExporter):
+    """Writes ``dev-stats.prom`` for the node_exporter textfile collector.
+
+    Gauges per file: function count, average and maximum cyclomatic
+    complexity, total lines and undocumented exports.  For a live scrape
+    target see
+    :class:`~dev_stats.output.exporters.metrics_handler.MetricsHandler`.
+    """
+
+    def export(self, output_dir: Path) -> list[Path]:
+        """Write the metrics file to *output_dir*.
+
+        Args:
+            output_dir: Directory to write into.
+
+        Returns:
+            Single-element list with the path to ``dev-stats.prom``.
+        """
+        output_dir.mkdir(parents=True, exist_ok=True)
+        out_path = output_dir / "dev-stats.prom"
+        out_path.write_text(render_metrics(self._report), encoding="utf-8")
+        return [out_path]
@@ -21,7 +21,8 @@ class TerminalReporter(AbstractExporter):
     """Prints analysis results to the terminal using Rich.
 
     Renders a hero panel with summary stats, a language breakdown table,
-    and top-N lists for files, classes, and methods.
+    and top-N lists for files, classes, and methods, plus the N least
+    maintainable functions.
     """
 
     def __init__(
@@ -55,8 +56,10 @@ class TerminalReporter(AbstractExporter):
         """
         self._print_hero()
         self._print_language_table()
+        self._print_go_module()
         self._print_top_files()
         self._print_top_methods()
+        self._print_lowest_maintainability()
         return []
 
     def _print_hero(self) -> None:
@@ -107,6 +110,21 @@ class TerminalReporter(AbstractExporter):
 
         self._console.print(table)
 
+    def _print_go_module(self) -> None:
+        """Print the Go module summary when ``go.mod`` was read."""
+        mod = self._report.go_module
+        if mod is None:
+            return
+
+        table = Table(title=f"Go Module {mod.module_path}", show_header=False)
+        table.add_column("Key", style="bold")
+        table.add_column("Value", justify="right")
+        table.add_row("Go version", mod.go_version or "-")
+        table.add_row("Direct dependencies", str(mod.num_direct_dependencies))
+        table.add_row("Indirect dependencies", str(mod.num_indirect_dependencies))
+
+        self._console.print(table)
+
     def _print_top_files(self) -> None:
         """Print top-N files by the configured sort key."""
         sort_funcs: dict[str, Any] = {
@@ -169,3 +187,30 @@ class TerminalReporter(AbstractExporter):
             table.add_row(name, file_path, str(cc))
 
         self._console.print(table)
+
+    def _print_lowest_maintainability(self) -> None:
+        """Print the N functions with the lowest maintainability index.
+
+        Functions without Halstead data have no index and are skipped.
+        """
+        scored: list[tuple[str, str, float]] = []
+        for f in self._report.files:
+            for name, func in f.qualified_functions:
+                mi = func.maintainability_index
+                if mi is not None:
+                    scored.append((name, str(f.path), mi))
+
+        scored.sort(key=lambda x: x[2])
+        lowest = scored[: self._top_n]
+        if not lowest:
+            return
+
+        table = Table(title=f"Bottom {len(lowest)} Functions by MI")
+        table.add_column("Function", style="yellow")
+        table.add_column("File")
+        table.add_column("MI", justify="right")
+
+        for name, file_path, mi in lowest:
+            table.add_row(name, file_path, f"{mi:.1f}")
+
+        self._console.print(table)
@@ -7,6 +7,8 @@
 //   Methods:    2  (Add, Reset)
 //   Imports:    fmt
 //   CC(Add)     = 3  (if / else if / else)
+//   LOC:        52 total, 7 blank, 17 comment, 28 code
+//               (lines with trailing // comments count as code)
 
 package main
 
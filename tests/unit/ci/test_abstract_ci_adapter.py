@@ -16,6 +16,7 @@ from dev_stats.core.models import (
     MethodReport,
     ParameterReport,
     RepoReport,
+    SwitchSite,
 )
 
 
@@ -107,6 +108,27 @@ class TestFileViolations:
         assert len(violations) == 1
         assert violations[0].rule == "max_imports"
 
+    def test_max_file_functions(self) -> None:
+        """Functions and methods together count toward max_file_functions."""
+        config = _make_config(max_file_functions=2)
+        cls = ClassReport(
+            name="Calc",
+            line=1,
+            end_line=10,
+            lines=10,
+            methods=(_make_method(name="add"), _make_method(name="reset")),
+        )
+        f = _make_file(classes=(cls,), functions=(_make_method(name="helper"),))
+        report = RepoReport(root=Path("."), files=(f,))
+        adapter = _ConcreteAdapter(report=report, config=config)
+
+        violations = adapter.check_violations()
+
+        assert len(violations) == 1
+        assert violations[0].rule == "max_file_functions"
+        assert violations[0].value == 3.0
+        assert violations[0].symbol == ""
+
 
 class TestFunctionViolations:
     """Function-level threshold checks."""
@@ -162,6 +184,33 @@ class TestFunctionViolations:
 
         assert any(v.rule == "max_parameters" for v in violations)
 
+    def test_max_return_values(self) -> None:
+        """A function exceeding max_return_values triggers a violation."""
+        config = _make_config(max_return_values=2)
+        func = _make_method(return_count=4)
+        f = _make_file(functions=(func,))
+        report = RepoReport(root=Path("."), files=(f,))
+        adapter = _ConcreteAdapter(report=report, config=config)
+
+        violations = adapter.check_violations()
+
+        assert any(v.rule == "max_return_values" for v in violations)
+
+    def test_require_default_case(self) -> None:
+        """Switches without default are flagged only when the rule is enabled."""
+        site = SwitchSite(cases=2, line=7, column=5, function="Classify")
+        f = _make_file(path="main.go", language="go", missing_default_switches=(site,))
+        report = RepoReport(root=Path("."), files=(f,))
+
+        assert _ConcreteAdapter(report=report, config=_make_config()).check_violations() == ()
+
+        config = _make_config(require_default_case=True)
+        (violation,) = _ConcreteAdapter(report=report, config=config).check_violations()
+        assert violation.rule == "require_default_case"
+        assert (violation.file_path, violation.line, violation.symbol) == ("main.go", 7, "Classify")
+        assert violation.column == 5
+        assert "no default" in violation.message
+
     def test_max_nesting_depth(self) -> None:
         """A function exceeding max_nesting_depth triggers a violation."""
         config = _make_config(max_nesting_depth=2)
@@ -175,6 +224,39 @@ class TestFunctionViolations:
         assert any(v.rule == "max_nesting_depth" for v in violations)
 
 
+class TestViolationSymbols:
+    """Violations name the offending symbol."""
+
+    def test_function_and_method_symbols(self) -> None:
+        """Functions report their name; methods report ``Class.method``."""
+        config = _make_config(max_cyclomatic_complexity=3)
+        helper = _make_method(name="Helper", cyclomatic_complexity=4)
+        ok = _make_method(name="Fine", cyclomatic_complexity=1)
+        add = _make_method(name="Add", cyclomatic_complexity=5)
+        cls = ClassReport(name="Calculator", line=1, end_line=30, lines=30, methods=(add,))
+        f = _make_file(functions=(helper, ok), classes=(cls,))
+        report = RepoReport(root=Path("."), files=(f,))
+        adapter = _ConcreteAdapter(report=report, config=config)
+
+        violations = adapter.check_violations()
+
+        assert len(violations) == 2
+        assert [v.symbol for v in violations] == ["Helper", "Calculator.Add"]
+        assert [v.value for v in violations] == [4.0, 5.0]
+        assert all(v.threshold == 3.0 for v in violations)
+
+    def test_class_symbol(self) -> None:
+        """Class-level violations report the class name."""
+        config = _make_config(max_class_lines=10)
+        cls = ClassReport(name="Big", line=1, end_line=50, lines=50)
+        report = RepoReport(root=Path("."), files=(_make_file(classes=(cls,)),))
+        adapter = _ConcreteAdapter(report=report, config=config)
+
+        violations = adapter.check_violations()
+
+        assert [v.symbol for v in violations] == ["Big"]
+
+
 class TestClassViolations:
     """Class-level threshold checks."""
 
@@ -216,6 +298,39 @@ class TestClassViolations:
 
         assert any(v.rule == "max_function_lines" for v in violations)
 
+    def test_max_interface_methods(self) -> None:
+        """An interface declaring too many methods triggers a violation."""
+        config = _make_config(max_interface_methods=2)
+        specs = tuple(_make_method(name=f"M{i}", line=i + 2, lines=1) for i in range(3))
+        iface = ClassReport(
+            name="Wide",
+            line=1,
+            end_line=5,
+            lines=5,
+            decorators=("interface",),
+            interface_methods=specs,
+        )
+        f = _make_file("wide.go", language="go", classes=(iface,))
+        report = RepoReport(root=Path("."), files=(f,))
+        adapter = _ConcreteAdapter(report=report, config=config)
+
+        violations = [v for v in adapter.check_violations() if v.rule == "max_interface_methods"]
+
+        assert len(violations) == 1
+        assert violations[0].symbol == "Wide"
+        assert violations[0].value == 3.0
+        assert violations[0].threshold == 2.0
+
+    def test_max_interface_methods_ignores_structs(self) -> None:
+        """Only interfaces are measured against max_interface_methods."""
+        config = _make_config(max_interface_methods=1)
+        methods = tuple(_make_method(name=f"m{i}", line=i * 10) for i in range(3))
+        cls = ClassReport(name="Impl", line=1, end_line=30, lines=30, methods=methods)
+        report = RepoReport(root=Path("."), files=(_make_file(classes=(cls,)),))
+        adapter = _ConcreteAdapter(report=report, config=config)
+
+        assert not any(v.rule == "max_interface_methods" for v in adapter.check_violations())
+
 
 class TestRepoViolations:
     """Repo-wide threshold checks."""
@@ -0,0 +1,136 @@
+"""Tests for the linter-style violation writer."""
+
+from __future__ import annotations
+
+import io
+from pathlib import Path
+
+import pytest
+
+from dev_stats.ci.lint_output import write_lint
+from dev_stats.ci.sarif_adapter import SarifAdapter
+from dev_stats.ci.violation import Violation, ViolationSeverity
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.config.threshold_config import ThresholdConfig
+from dev_stats.core.models import FileReport, MethodReport, ParameterReport, RepoReport
+
+_VIOLATION = Violation(
+    rule="max_cyclomatic_complexity",
+    message="Calc.Add: CC=12 exceeds limit of 10",
+    file_path="calc/calc.go",
+    line=42,
+    column=6,
+    severity=ViolationSeverity.ERROR,
+    value=12.0,
+    threshold=10.0,
+)
+
+
+def _render(violations: list[Violation], fmt: str) -> str:
+    """Return what write_lint writes for *violations*."""
+    stream = io.StringIO()
+    write_lint(stream, violations, fmt)
+    return stream.getvalue()
+
+
+class TestTextFormat:
+    """Tests for ``file:line:col: message`` output."""
+
+    def test_known_violation(self) -> None:
+        """The standard compiler/linter location prefix is used."""
+        assert _render([_VIOLATION], "text") == (
+            "calc/calc.go:42:6: Calc.Add: CC=12 exceeds limit of 10 "
+            "(max_cyclomatic_complexity)\n"
+        )
+
+    def test_file_level_and_repo_wide(self) -> None:
+        """Missing line/column default to 1; repo-wide violations have no location."""
+        file_level = Violation(rule="max_imports", message="too many imports", file_path="a.go")
+        repo_wide = Violation(rule="max_duplication_pct", message="Duplication 9.0%")
+        assert _render([file_level, repo_wide], "text") == (
+            "a.go:1:1: too many imports (max_imports)\nDuplication 9.0% (max_duplication_pct)\n"
+        )
+
+
+class TestGithubActionsFormat:
+    """Tests for ``::error file=...`` workflow commands."""
+
+    def test_known_violation(self) -> None:
+        """ERROR violations become ``::error`` commands with a location."""
+        assert _render([_VIOLATION], "github-actions") == (
+            "::error file=calc/calc.go,line=42,col=6,title=max_cyclomatic_complexity"
+            "::Calc.Add: CC=12 exceeds limit of 10\n"
+        )
+
+    def test_severity_and_escaping(self) -> None:
+        """Warnings map to ``::warning``; reserved characters are percent-encoded."""
+        v = Violation(rule="r", message="50% done\nnext", file_path="a,b.go", line=3)
+        assert _render([v], "github-actions") == (
+            "::warning file=a%2Cb.go,line=3,col=1,title=r::50%25 done%0Anext\n"
+        )
+
+
+class TestTeamCityFormat:
+    """Tests for TeamCity inspection service messages."""
+
+    def test_known_violation(self) -> None:
+        """One inspectionType per rule precedes the inspections."""
+        assert _render([_VIOLATION, _VIOLATION], "teamcity") == (
+            "##teamcity[inspectionType id='max_cyclomatic_complexity' "
+            "name='max_cyclomatic_complexity' category='dev-stats' "
+            "description='max_cyclomatic_complexity']\n"
+            + 2
+            * (
+                "##teamcity[inspection typeId='max_cyclomatic_complexity' "
+                "message='Calc.Add: CC=12 exceeds limit of 10' file='calc/calc.go' "
+                "line='42' SEVERITY='ERROR']\n"
+            )
+        )
+
+    def test_escaping_and_repo_wide(self) -> None:
+        """Values are TeamCity-escaped; repo-wide violations carry no file."""
+        v = Violation(rule="dup", message="it's [bad]")
+        assert _render([v], "teamcity").splitlines()[1] == (
+            "##teamcity[inspection typeId='dup' message='it|'s |[bad|]' SEVERITY='WARNING']"
+        )
+
+
+class TestWriteLint:
+    """Tests shared by every format."""
+
+    def test_unknown_format(self) -> None:
+        """An unsupported format raises ValueError."""
+        with pytest.raises(ValueError, match="unknown lint format"):
+            _render([_VIOLATION], "checkstyle")
+
+    def test_no_violations(self) -> None:
+        """Nothing is written without violations."""
+        assert _render([], "teamcity") == ""
+
+    def test_adapter_location_prefix_dropped(self) -> None:
+        """Messages from ``check_violations`` are not prefixed with their location twice."""
+        params = tuple(ParameterReport(name=f"p{i}") for i in range(8))
+        func = MethodReport(name="F", line=3, end_line=5, lines=3, parameters=params)
+        report = RepoReport(
+            root=Path("."),
+            files=(
+                FileReport(
+                    path=Path("a.go"),
+                    language="go",
+                    total_lines=900,
+                    code_lines=900,
+                    blank_lines=0,
+                    comment_lines=0,
+                    functions=(func,),
+                ),
+            ),
+        )
+        config = AnalysisConfig(thresholds=ThresholdConfig(max_parameters=5, max_file_lines=500))
+        violations = SarifAdapter(report=report, config=config).check_violations()
+
+        lines = _render(list(violations), "text").splitlines()
+        assert "a.go:1:1: 900 lines exceeds limit of 500 (max_file_lines)" in lines
+        assert "a.go:3:1: F: 8 parameters exceeds limit of 5 (max_parameters)" in lines
+        assert all(line.count("a.go") == 1 for line in lines)
+        github = _render(list(violations), "github-actions")
+        assert "::F: 8 parameters" in github
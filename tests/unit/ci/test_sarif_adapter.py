@@ -0,0 +1,148 @@
+"""Tests for SarifAdapter."""
+
+from __future__ import annotations
+
+import json
+from pathlib import Path
+
+from dev_stats.ci.sarif_adapter import SarifAdapter
+from dev_stats.ci.violation import Violation, ViolationSeverity
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.models import ClassReport, FileReport, MethodReport, RepoReport
+
+
+def _make_adapter(
+    violations: tuple[Violation, ...] = (),
+) -> SarifAdapter:
+    """Create a SarifAdapter pre-loaded with violations."""
+    config = AnalysisConfig()
+    report = RepoReport(root=Path("."))
+    adapter = SarifAdapter(report=report, config=config)
+    adapter._violations = violations
+    return adapter
+
+
+class TestEmit:
+    """SARIF log structure tests."""
+
+    def test_log_header(self) -> None:
+        """The log declares SARIF 2.1.0 and names the tool."""
+        data = json.loads(_make_adapter().emit())
+
+        assert data["version"] == "2.1.0"
+        assert "sarif-2.1.0" in data["$schema"]
+        driver = data["runs"][0]["tool"]["driver"]
+        assert driver["name"] == "dev-stats"
+        assert data["runs"][0]["results"] == []
+        assert data["runs"][0]["originalUriBaseIds"]["%SRCROOT%"]["uri"].endswith("/")
+
+    def test_result_structure(self) -> None:
+        """Each violation maps to ruleId, level, message and location."""
+        v = Violation(
+            rule="max_cyclomatic_complexity",
+            message="CC=12 exceeds limit of 10",
+            file_path="pkg/calc.go",
+            line=30,
+            severity=ViolationSeverity.ERROR,
+            symbol="Calculator.Add",
+        )
+        result = json.loads(_make_adapter((v,)).emit())["runs"][0]["results"][0]
+
+        assert result["ruleId"] == "max_cyclomatic_complexity"
+        assert result["level"] == "error"
+        assert result["message"]["text"] == "CC=12 exceeds limit of 10"
+        location = result["locations"][0]
+        physical = location["physicalLocation"]
+        assert physical["artifactLocation"]["uri"] == "pkg/calc.go"
+        assert physical["artifactLocation"]["uriBaseId"] == "%SRCROOT%"
+        assert physical["region"] == {"startLine": 30}
+        assert location["logicalLocations"] == [{"name": "Calculator.Add"}]
+
+    def test_column_in_region(self) -> None:
+        """A known column is reported as startColumn."""
+        v = Violation(rule="require_default_case", message="m", file_path="a.go", line=7, column=5)
+        result = json.loads(_make_adapter((v,)).emit())["runs"][0]["results"][0]
+        region = result["locations"][0]["physicalLocation"]["region"]
+        assert region == {"startLine": 7, "startColumn": 5}
+
+    def test_rules_deduplicated_and_indexed(self) -> None:
+        """Rules appear once and results point at them by index."""
+        violations = (
+            Violation(rule="max_file_lines", message="a", file_path="a.py"),
+            Violation(rule="max_function_lines", message="b", file_path="b.py", line=3),
+            Violation(rule="max_file_lines", message="c", file_path="c.py"),
+        )
+        run = json.loads(_make_adapter(violations).emit())["runs"][0]
+        rules = [r["id"] for r in run["tool"]["driver"]["rules"]]
+
+        assert rules == ["max_file_lines", "max_function_lines"]
+        for result in run["results"]:
+            assert rules[result["ruleIndex"]] == result["ruleId"]
+
+    def test_file_level_and_repo_wide_locations(self) -> None:
+        """Line 0 omits the region; repo-wide violations omit locations."""
+        violations = (
+            Violation(rule="max_imports", message="m", file_path="x.py"),
+            Violation(rule="max_duplication_pct", message="d", severity=ViolationSeverity.INFO),
+        )
+        file_level, repo_wide = json.loads(_make_adapter(violations).emit())["runs"][0]["results"]
+
+        assert "region" not in file_level["locations"][0]["physicalLocation"]
+        assert "locations" not in repo_wide
+        assert repo_wide["level"] == "note"
+
+    def test_threshold_violations_end_to_end(self) -> None:
+        """Violations found by the threshold engine land at the right files."""
+        long_func = MethodReport(name="Big", line=5, end_line=200, lines=196)
+        files = (
+            FileReport(
+                path=Path("svc/big.go"),
+                language="go",
+                total_lines=250,
+                code_lines=240,
+                blank_lines=10,
+                comment_lines=0,
+                size_bytes=5000,
+                functions=(long_func,),
+            ),
+            FileReport(
+                path=Path("svc/model.go"),
+                language="go",
+                total_lines=20,
+                code_lines=20,
+                blank_lines=0,
+                comment_lines=0,
+                size_bytes=400,
+                classes=(
+                    ClassReport(
+                        name="Model",
+                        line=1,
+                        end_line=20,
+                        lines=20,
+                        methods=(MethodReport(name="Save", line=2, end_line=150, lines=149),),
+                    ),
+                ),
+            ),
+        )
+        report = RepoReport(root=Path("."), files=files)
+        adapter = SarifAdapter(report=report, config=AnalysisConfig())
+        violations = adapter.check_violations()
+
+        results = json.loads(adapter.emit())["runs"][0]["results"]
+
+        assert len(results) == len(violations) > 0
+        uris = {r["locations"][0]["physicalLocation"]["artifactLocation"]["uri"] for r in results}
+        assert uris == {"svc/big.go", "svc/model.go"}
+
+
+class TestWriteReport:
+    """File-output tests."""
+
+    def test_writes_sarif_file(self, tmp_path: Path) -> None:
+        """write_report creates dev-stats.sarif with the emitted log."""
+        v = Violation(rule="r", message="m", file_path="f.py", line=1)
+        adapter = _make_adapter((v,))
+        paths = adapter.write_report(tmp_path)
+
+        assert paths == [tmp_path / "dev-stats.sarif"]
+        assert json.loads(paths[0].read_text(encoding="utf-8")) == json.loads(adapter.emit())
@@ -4,7 +4,7 @@ from __future__ import annotations
 
 from pathlib import Path
 
-from dev_stats.ci.teamcity_adapter import TeamCityAdapter, _escape_tc
+from dev_stats.ci.teamcity_adapter import TeamCityAdapter, escape_tc
 from dev_stats.ci.violation import Violation, ViolationSeverity
 from dev_stats.config.analysis_config import AnalysisConfig
 from dev_stats.core.models import FileReport, RepoReport
@@ -27,27 +27,27 @@ class TestEscaping:
 
     def test_pipe_escape(self) -> None:
         """Pipes are doubled."""
-        assert _escape_tc("a|b") == "a||b"
+        assert escape_tc("a|b") == "a||b"
 
     def test_quote_escape(self) -> None:
         """Single quotes are escaped."""
-        assert _escape_tc("it's") == "it|'s"
+        assert escape_tc("it's") == "it|'s"
 
     def test_newline_escape(self) -> None:
         """Newlines become |n."""
-        assert _escape_tc("a\nb") == "a|nb"
+        assert escape_tc("a\nb") == "a|nb"
 
     def test_carriage_return_escape(self) -> None:
         """Carriage returns become |r."""
-        assert _escape_tc("a\rb") == "a|rb"
+        assert escape_tc("a\rb") == "a|rb"
 
     def test_bracket_escape(self) -> None:
         """Square brackets are escaped."""
-        assert _escape_tc("[x]") == "|[x|]"
+        assert escape_tc("[x]") == "|[x|]"
 
     def test_combined_escape(self) -> None:
         """Multiple special characters are all escaped."""
-        assert _escape_tc("a|b\n[c]") == "a||b|n|[c|]"
+        assert escape_tc("a|b\n[c]") == "a||b|n|[c|]"
 
 
 class TestEmit:
@@ -2,6 +2,7 @@
 
 from __future__ import annotations
 
+import functools
 from pathlib import Path
 from typing import TYPE_CHECKING
 from unittest.mock import MagicMock, patch
@@ -10,6 +11,7 @@ import pytest
 from typer.testing import CliRunner
 
 from dev_stats.cli.app import app
+from dev_stats.core.dispatcher import Dispatcher
 
 if TYPE_CHECKING:
     from collections.abc import Generator
@@ -76,12 +78,16 @@ def mock_pipeline(tmp_path: Path) -> Generator[MagicMock, None, None]:
         patch(f"{_MODULE}.JsonExporter") as mock_json_cls,
         patch(f"{_MODULE}.CsvExporter") as mock_csv_cls,
         patch(f"{_MODULE}.XmlExporter") as mock_xml_cls,
+        patch(f"{_MODULE}.HtmlExporter") as mock_html_cls,
         patch(f"{_MODULE}.BadgeGenerator") as mock_badge_cls,
         patch(f"{_MODULE}.DashboardBuilder") as mock_dashboard_cls,
+        patch(f"{_MODULE}.PrometheusExporter") as mock_prometheus_cls,
     ):
         # Config
         cfg = MagicMock()
         cfg.output.top_n = 20
+        cfg.jobs = 1
+        cfg.cache_dir = None
         cfg.branches = MagicMock()
         mock_config_cls.load.return_value = cfg
         cfg.model_copy.return_value = cfg
@@ -91,7 +97,7 @@ def mock_pipeline(tmp_path: Path) -> Generator[MagicMock, None, None]:
 
         # Registry + Dispatcher
         mock_registry.return_value = MagicMock()
-        mock_dispatcher_cls.return_value.parse.return_value = _make_file_report()
+        mock_dispatcher_cls.return_value.parse_many.return_value = [_make_file_report()]
 
         # Git modules
         mock_harvester_cls.return_value.harvest.return_value = []
@@ -109,8 +115,10 @@ def mock_pipeline(tmp_path: Path) -> Generator[MagicMock, None, None]:
         mock_json_cls.return_value.export.return_value = [Path("report.json")]
         mock_csv_cls.return_value.export.return_value = [Path("report.csv")]
         mock_xml_cls.return_value.export.return_value = [Path("report.xml")]
+        mock_html_cls.return_value.export.return_value = [Path("report.html")]
         mock_badge_cls.return_value.export.return_value = [Path("badge.svg")]
         mock_dashboard_cls.return_value.export.return_value = [Path("dashboard.html")]
+        mock_prometheus_cls.return_value.export.return_value = [Path("dev-stats.prom")]
 
         # Expose mocks on a carrier object
         carrier = MagicMock()
@@ -128,8 +136,10 @@ def mock_pipeline(tmp_path: Path) -> Generator[MagicMock, None, None]:
         carrier.json_cls = mock_json_cls
         carrier.csv_cls = mock_csv_cls
         carrier.xml_cls = mock_xml_cls
+        carrier.html_cls = mock_html_cls
         carrier.badge_cls = mock_badge_cls
         carrier.dashboard_cls = mock_dashboard_cls
+        carrier.prometheus_cls = mock_prometheus_cls
         carrier.report = report
         carrier.cfg = cfg
 
@@ -167,8 +177,24 @@ class TestAnalyseCommand:
         mock_pipeline.json_cls.return_value.export.assert_called()
         mock_pipeline.csv_cls.return_value.export.assert_called_once()
         mock_pipeline.xml_cls.return_value.export.assert_called_once()
+        mock_pipeline.html_cls.return_value.export.assert_called_once()
         mock_pipeline.badge_cls.return_value.export.assert_called_once()
         mock_pipeline.dashboard_cls.return_value.export.assert_called_once()
+        mock_pipeline.prometheus_cls.return_value.export.assert_called_once()
+
+    def test_analyse_format_prometheus(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--format prometheus`` writes the metrics file only."""
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--format", "prometheus"])
+        assert result.exit_code == 0
+        mock_pipeline.prometheus_cls.return_value.export.assert_called_once()
+        mock_pipeline.json_cls.return_value.export.assert_not_called()
+
+    def test_analyse_format_html(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--format html`` writes the static HTML report only."""
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--format", "html"])
+        assert result.exit_code == 0
+        mock_pipeline.html_cls.return_value.export.assert_called_once()
+        mock_pipeline.dashboard_cls.return_value.export.assert_not_called()
 
     def test_analyse_format_dashboard(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
         """``--format dashboard`` builds the HTML dashboard."""
@@ -182,6 +208,78 @@ class TestAnalyseCommand:
         assert result.exit_code == 0
         mock_pipeline.cfg.model_copy.assert_called_once()
 
+    def test_analyse_no_recursive(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--no-recursive`` is forwarded to the config loader."""
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--no-recursive"])
+        assert result.exit_code == 0
+        _, kwargs = mock_pipeline.config_cls.load.call_args
+        assert kwargs["recursive"] is False
+
+    def test_analyse_recursive_defaults_to_config(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """Without the flag the config value is left untouched."""
+        result = runner.invoke(app, ["analyse", str(tmp_path)])
+        assert result.exit_code == 0
+        _, kwargs = mock_pipeline.config_cls.load.call_args
+        assert kwargs["recursive"] is None
+
+    def test_analyse_exclude_tests_and_generated(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """``--exclude-tests`` and ``--exclude-generated`` reach the config loader."""
+        result = runner.invoke(
+            app, ["analyse", str(tmp_path), "--exclude-tests", "--exclude-generated"]
+        )
+        assert result.exit_code == 0
+        _, kwargs = mock_pipeline.config_cls.load.call_args
+        assert kwargs["exclude_tests"] is True
+        assert kwargs["exclude_generated"] is True
+
+    def test_analyse_exclusions_default_to_config(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """Without the flags the config values are left untouched."""
+        result = runner.invoke(app, ["analyse", str(tmp_path)])
+        assert result.exit_code == 0
+        _, kwargs = mock_pipeline.config_cls.load.call_args
+        assert kwargs["exclude_tests"] is None
+        assert kwargs["exclude_generated"] is None
+
+    def test_analyse_jobs_uses_parse_many(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--jobs`` reaches the config loader and switches to parallel parsing."""
+        cfg = mock_pipeline.config_cls.load.return_value
+        cfg.jobs = 4
+        dispatcher = mock_pipeline.dispatcher_cls.return_value
+        dispatcher.parse_many.return_value = [_make_file_report()]
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--jobs", "4"])
+        assert result.exit_code == 0
+        _, kwargs = mock_pipeline.config_cls.load.call_args
+        assert kwargs["jobs"] == 4
+        assert dispatcher.parse_many.call_args.kwargs["jobs"] == 4
+        dispatcher.parse.assert_not_called()
+
+    def test_analyse_module_reads_go_mod(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--module`` parses go.mod and hands it to the aggregator."""
+        (tmp_path / "go.mod").write_text(
+            "module example.com/app\n\ngo 1.22\n\n"
+            "require (\n\tgithub.com/a/b v1.0.0\n\tgithub.com/c/d v0.2.0 // indirect\n)\n"
+        )
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--module"])
+        assert result.exit_code == 0
+        assert "example.com/app" in result.output
+        go_module = mock_pipeline.aggregator_cls.return_value.aggregate.call_args.kwargs[
+            "go_module"
+        ]
+        assert go_module.num_direct_dependencies == 1
+        assert go_module.num_indirect_dependencies == 1
+
+    def test_analyse_module_missing_go_mod(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--module`` without a go.mod exits with an error."""
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--module"])
+        assert result.exit_code == 1
+        assert "go.mod" in result.output
+
     def test_analyse_ci_github(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
         """``--ci github`` invokes the GitHub Actions adapter."""
         with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
@@ -212,6 +310,148 @@ class TestAnalyseCommand:
             )
         assert result.exit_code == 1
 
+    def test_analyse_max_cc_gates(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--max-cc`` overrides the threshold, prints violations, and exits 1."""
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            from dev_stats.ci.violation import Violation, ViolationSeverity
+
+            adapter = MagicMock()
+            adapter.violations = (
+                Violation(
+                    rule="max_cyclomatic_complexity",
+                    message="a.go:3 Helper: CC=9 exceeds limit of 5",
+                    severity=ViolationSeverity.ERROR,
+                    symbol="Helper",
+                ),
+            )
+            mock_ci.return_value = adapter
+            result = runner.invoke(app, ["analyse", str(tmp_path), "--max-cc", "5"])
+        assert result.exit_code == 1
+        assert "Helper: CC=9 exceeds limit of 5" in result.output
+        cfg = mock_pipeline.config_cls.load.return_value
+        cfg.thresholds.model_copy.assert_called_once_with(
+            update={"max_cyclomatic_complexity": 5}
+        )
+
+    def test_analyse_max_cc_passes(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--max-cc`` exits 0 when nothing exceeds the limit."""
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            adapter = MagicMock()
+            adapter.violations = ()
+            mock_ci.return_value = adapter
+            result = runner.invoke(app, ["analyse", str(tmp_path), "--max-cc", "50"])
+        assert result.exit_code == 0
+        adapter.check_violations.assert_called_once()
+
+    def test_analyse_max_nesting_gates(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--max-nesting`` overrides the nesting threshold and enables the gate."""
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            from dev_stats.ci.violation import Violation, ViolationSeverity
+
+            adapter = MagicMock()
+            adapter.violations = (
+                Violation(
+                    rule="max_nesting_depth",
+                    message="a.go:3 Walk: nesting depth 4 exceeds limit of 2",
+                    severity=ViolationSeverity.WARNING,
+                    symbol="Walk",
+                ),
+            )
+            mock_ci.return_value = adapter
+            result = runner.invoke(app, ["analyse", str(tmp_path), "--max-nesting", "2"])
+        assert result.exit_code == 1
+        assert "nesting depth 4 exceeds limit of 2" in result.output
+        cfg = mock_pipeline.config_cls.load.return_value
+        cfg.thresholds.model_copy.assert_called_once_with(update={"max_nesting_depth": 2})
+
+    def test_analyse_max_params_and_returns(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """``--max-params`` and ``--max-returns`` override their thresholds together."""
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            adapter = MagicMock()
+            adapter.violations = ()
+            mock_ci.return_value = adapter
+            result = runner.invoke(
+                app, ["analyse", str(tmp_path), "--max-params", "4", "--max-returns", "2"]
+            )
+        assert result.exit_code == 0
+        adapter.check_violations.assert_called_once()
+        cfg = mock_pipeline.config_cls.load.return_value
+        cfg.thresholds.model_copy.assert_called_once_with(
+            update={"max_parameters": 4, "max_return_values": 2}
+        )
+
+    def test_analyse_require_default_case(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """``--require-default-case`` enables the rule and the gate."""
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            adapter = MagicMock()
+            adapter.violations = ()
+            mock_ci.return_value = adapter
+            result = runner.invoke(app, ["analyse", str(tmp_path), "--require-default-case"])
+        assert result.exit_code == 0
+        adapter.check_violations.assert_called_once()
+        cfg = mock_pipeline.config_cls.load.return_value
+        cfg.thresholds.model_copy.assert_called_once_with(update={"require_default_case": True})
+
+    def test_analyse_max_interface_methods(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """``--max-interface-methods`` overrides its threshold and enables the gate."""
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            adapter = MagicMock()
+            adapter.violations = ()
+            mock_ci.return_value = adapter
+            result = runner.invoke(
+                app, ["analyse", str(tmp_path), "--max-interface-methods", "5"]
+            )
+        assert result.exit_code == 0
+        adapter.check_violations.assert_called_once()
+        cfg = mock_pipeline.config_cls.load.return_value
+        cfg.thresholds.model_copy.assert_called_once_with(update={"max_interface_methods": 5})
+
+    def test_analyse_lint_text(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--lint text`` prints violations as ``file:line:col: message``."""
+        from dev_stats.ci.violation import Violation
+
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            adapter = MagicMock()
+            adapter.violations = (
+                Violation(rule="max_file_lines", message="too long", file_path="a.go", line=3),
+            )
+            mock_ci.return_value = adapter
+            result = runner.invoke(app, ["analyse", str(tmp_path), "--lint", "text"])
+        assert result.exit_code == 0
+        adapter.check_violations.assert_called_once()
+        assert "a.go:3:1: too long (max_file_lines)\n" in result.output
+
+    def test_analyse_lint_keeps_stdout_clean(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """With ``--lint`` stdout holds only lint lines; progress goes to stderr."""
+        from dev_stats.ci.violation import Violation
+
+        with patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci:
+            adapter = MagicMock()
+            adapter.violations = (
+                Violation(rule="max_file_lines", message="too long", file_path="a.go", line=3),
+            )
+            mock_ci.return_value = adapter
+            result = runner.invoke(app, ["analyse", str(tmp_path), "--lint", "text"])
+        assert result.exit_code == 0
+        assert result.stdout == "a.go:3:1: too long (max_file_lines)\n"
+        assert "Aggregated results" in result.stderr
+        mock_pipeline.reporter_cls.return_value.export.assert_not_called()
+
+    def test_analyse_lint_unknown_format(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """An unknown ``--lint`` format exits with code 1 before analysing."""
+        result = runner.invoke(app, ["analyse", str(tmp_path), "--lint", "xml"])
+        assert result.exit_code == 1
+        assert "unknown lint format" in result.output
+        mock_pipeline.config_cls.load.assert_not_called()
+
     def test_analyse_file_not_found(self, tmp_path: Path) -> None:
         """Non-existent path raises exit code 1."""
         bad_path = tmp_path / "does_not_exist"
@@ -226,10 +466,74 @@ class TestAnalyseCommand:
         scanner.scan.return_value = [tmp_path / "a.py", tmp_path / "b.py"]
         dispatcher = mock_pipeline.dispatcher_cls.return_value
         dispatcher.parse.side_effect = [OSError("bad parse"), _make_file_report()]
+        dispatcher.parse_many.side_effect = functools.partial(Dispatcher.parse_many, dispatcher)
 
         result = runner.invoke(app, ["analyse", str(tmp_path)])
         assert result.exit_code == 0
         assert dispatcher.parse.call_count == 2
+        assert "Parsed 1 file(s)" in result.output
+
+    def test_analyse_default_jobs_uses_parse_many(
+        self, mock_pipeline: MagicMock, tmp_path: Path
+    ) -> None:
+        """A single job also parses through ``parse_many``, which sorts by path."""
+        dispatcher = mock_pipeline.dispatcher_cls.return_value
+        result = runner.invoke(app, ["analyse", str(tmp_path)])
+        assert result.exit_code == 0
+        assert dispatcher.parse_many.call_args.kwargs["jobs"] == 1
+        dispatcher.parse.assert_not_called()
+
+    def test_analyse_watch_rechecks_gates(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """``--watch`` re-runs the quality gates after each update and exits on the last one."""
+        from dev_stats.ci.violation import Violation, ViolationSeverity
+
+        failing = MagicMock()
+        failing.violations = (
+            Violation(
+                rule="max_cyclomatic_complexity",
+                message="a.go:3 F: CC=9 exceeds limit of 5",
+                severity=ViolationSeverity.ERROR,
+            ),
+        )
+        passing = MagicMock()
+        passing.violations = ()
+
+        def fake_run() -> None:
+            """Deliver one change batch, as the watch loop would."""
+            watch_cls.call_args.kwargs["on_changes"]({tmp_path / "a.go"})
+
+        with (
+            patch(f"{_MODULE}.AnalyseCommand._create_ci_adapter") as mock_ci,
+            patch("dev_stats.cli.watch_runner.WatchRunner") as watch_cls,
+            patch("dev_stats.core.report_updater.ReportUpdater") as updater_cls,
+        ):
+            mock_ci.side_effect = [passing, failing]
+            updater_cls.return_value.apply.return_value = mock_pipeline.report
+            watch_cls.return_value.run.side_effect = fake_run
+            result = runner.invoke(
+                app, ["analyse", str(tmp_path), "--watch", "--fail-on-violations"]
+            )
+
+        assert result.exit_code == 1
+        assert mock_ci.call_count == 2
+        assert updater_cls.call_args.kwargs["scanner"] is mock_pipeline.scanner_cls.return_value
+        assert "extensions" in watch_cls.call_args.kwargs
+
+
+class TestLanguageExtensions:
+    """Tests for ``AnalyseCommand._language_extensions``."""
+
+    def test_selects_language_extensions(self) -> None:
+        """Only the extensions of the named languages are returned."""
+        from dev_stats.cli.analyse_command import AnalyseCommand
+
+        registry = MagicMock()
+        registry.supported_languages.return_value = {"go": [".go"], "python": [".py", ".pyi"]}
+
+        assert AnalyseCommand._language_extensions(registry, ("Go",)) == frozenset({".go"})
+        assert AnalyseCommand._language_extensions(registry, ()) == frozenset(
+            {".go", ".py", ".pyi"}
+        )
 
 
 class TestCreateCIAdapter:
@@ -260,3 +564,97 @@ class TestGetDiffFiles:
             files = AnalyseCommand._get_diff_files(tmp_path, "main")
         assert files == {"src/foo.py", "src/bar.py"}
         mock_run.assert_called_once()
+
+
+class TestAnnotateSources:
+    """Tests for ``AnalyseCommand._annotate_sources`` (``--annotate``)."""
+
+    def test_dry_run_then_write(self, tmp_path: Path) -> None:
+        """``--dry-run`` leaves the file alone; otherwise comments are written."""
+        import io
+
+        from rich.console import Console
+
+        from dev_stats.cli.analyse_command import AnalyseCommand
+        from dev_stats.core.parsers.go_parser import GoParser
+
+        source = b"package p\r\n\r\nfunc F(n int) int {\r\n    return n\r\n}\r\n"
+        path = tmp_path / "p.go"
+        path.write_bytes(source)
+        reports = [GoParser().parse(path, tmp_path)]
+        buffer = io.StringIO()
+        console = Console(file=buffer, width=200)
+
+        AnalyseCommand._annotate_sources(reports, tmp_path, dry_run=True, console=console)
+        assert path.read_bytes() == source
+        assert "would annotate p.go" in buffer.getvalue()
+
+        AnalyseCommand._annotate_sources(reports, tmp_path, dry_run=False, console=console)
+        assert path.read_bytes() == source.replace(
+            b"int {\r\n", b"int { // devstats: cc=1 loc=3\r\n"
+        )
+        assert "Annotated 1 file(s)" in buffer.getvalue()
+
+    def test_refused_file_left_alone(self, tmp_path: Path) -> None:
+        """A signature line ending inside a raw string is reported, not written."""
+        import io
+
+        from rich.console import Console
+
+        from dev_stats.cli.analyse_command import AnalyseCommand
+        from dev_stats.core.parsers.go_parser import GoParser
+
+        source = b"package p\n\nfunc F() string { return `a\nb` }\n"
+        path = tmp_path / "p.go"
+        path.write_bytes(source)
+        buffer = io.StringIO()
+        console = Console(file=buffer, width=200)
+
+        reports = [GoParser().parse(path, tmp_path)]
+        AnalyseCommand._annotate_sources(reports, tmp_path, dry_run=False, console=console)
+        assert path.read_bytes() == source
+        assert "skipped p.go: line 3 of F ends inside a literal" in buffer.getvalue()
+        assert "Annotated 0 file(s)" in buffer.getvalue()
+
+
+class TestApplyCoverprofile:
+    """Tests for ``AnalyseCommand._apply_coverprofile`` (``--coverprofile``)."""
+
+    def test_lists_untested_go_functions(self, tmp_path: Path) -> None:
+        """Go functions outside executed blocks are printed; other files are left alone."""
+        import io
+
+        from rich.console import Console
+
+        from dev_stats.cli.analyse_command import AnalyseCommand
+        from dev_stats.core.parsers.go_parser import GoParser
+        from dev_stats.core.parsers.python_parser import PythonParser
+
+        (tmp_path / "go.mod").write_text("module example.com/p\n")
+        go_file = tmp_path / "p.go"
+        go_file.write_text("package p\n\nfunc A() {\n}\n\nfunc B() {\n}\n")
+        py_file = tmp_path / "m.py"
+        py_file.write_text("def f():\n    return 1\n")
+        profile = tmp_path / "cover.out"
+        profile.write_text(
+            "mode: set\nexample.com/p/p.go:3.10,4.2 0 1\nexample.com/p/p.go:6.10,7.2 0 0\n"
+            "example.com/p/cmd/p.go:1.1,9.2 0 1\n"
+        )
+        reports = [GoParser().parse(go_file, tmp_path), PythonParser().parse(py_file, tmp_path)]
+        buffer = io.StringIO()
+
+        result = AnalyseCommand._apply_coverprofile(
+            reports, profile, tmp_path, Console(file=buffer, width=200)
+        )
+
+        assert result[0].uncovered_functions == ["B"]
+        assert result[1] is reports[1]
+        assert "1 of 2 function(s) untested" in buffer.getvalue()
+        assert "p.go: B" in buffer.getvalue()
+
+    def test_missing_profile_fails(self, mock_pipeline: MagicMock, tmp_path: Path) -> None:
+        """A missing ``--coverprofile`` file exits with code 1."""
+        result = runner.invoke(
+            app, ["analyse", str(tmp_path), "--coverprofile", str(tmp_path / "none.out")]
+        )
+        assert result.exit_code == 1
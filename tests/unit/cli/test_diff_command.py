@@ -0,0 +1,74 @@
+"""Unit tests for the ``diff`` CLI command."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from typer.testing import CliRunner
+
+from dev_stats.cli.app import app
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.models import FileReport, MethodReport, RepoReport
+from dev_stats.output.exporters.json_exporter import JsonExporter
+
+runner = CliRunner()
+
+
+def _snapshot(tmp_path: Path, name: str, *functions: MethodReport) -> Path:
+    """Write a full JSON snapshot with one file holding *functions*.
+
+    Args:
+        tmp_path: Pytest temporary directory.
+        name: Sub-directory name for the snapshot.
+        *functions: Top-level functions of ``main.go``.
+
+    Returns:
+        Path to the written ``dev-stats.json``.
+    """
+    file_rpt = FileReport(
+        path=Path("main.go"),
+        language="go",
+        total_lines=30,
+        code_lines=20,
+        blank_lines=5,
+        comment_lines=5,
+        functions=functions,
+    )
+    report = RepoReport(root=tmp_path, files=(file_rpt,))
+    config = AnalysisConfig.load(repo_path=tmp_path)
+    return JsonExporter(report=report, config=config).export(tmp_path / name)[0]
+
+
+class TestDiffCommand:
+    """Tests for ``dev-stats diff``."""
+
+    def test_diff_reports_changes(self, tmp_path: Path) -> None:
+        """Added, removed and changed symbols are listed with arrows."""
+        before = _snapshot(
+            tmp_path,
+            "before",
+            MethodReport(name="Run", line=1, end_line=9, lines=9, cyclomatic_complexity=2),
+            MethodReport(name="Old", line=11, end_line=12, lines=2),
+        )
+        after = _snapshot(
+            tmp_path,
+            "after",
+            MethodReport(name="Run", line=1, end_line=7, lines=7, cyclomatic_complexity=4),
+            MethodReport(name="New", line=11, end_line=12, lines=2),
+        )
+        result = runner.invoke(
+            app, ["diff", "--diff-before", str(before), "--diff-after", str(after)]
+        )
+        assert result.exit_code == 0
+        assert "1 added, 1 removed, 1 changed" in result.output
+        assert "+ main.go::New" in result.output
+        assert "- main.go::Old" in result.output
+        assert "~ main.go::Run  CC 2 → 4 ↑, LOC 9 → 7 ↓" in result.output
+
+    def test_diff_missing_file(self, tmp_path: Path) -> None:
+        """A missing snapshot exits with code 1."""
+        missing = tmp_path / "nope.json"
+        result = runner.invoke(
+            app, ["diff", "--diff-before", str(missing), "--diff-after", str(missing)]
+        )
+        assert result.exit_code == 1
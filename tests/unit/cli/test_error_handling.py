@@ -89,6 +89,8 @@ def mock_pipeline(tmp_path: Path) -> Generator[MagicMock, None, None]:
         # Config
         cfg = MagicMock()
         cfg.output.top_n = 20
+        cfg.jobs = 1
+        cfg.cache_dir = None
         cfg.branches = MagicMock()
         mock_config_cls.load.return_value = cfg
         cfg.model_copy.return_value = cfg
@@ -98,7 +100,7 @@ def mock_pipeline(tmp_path: Path) -> Generator[MagicMock, None, None]:
 
         # Registry + Dispatcher
         mock_registry.return_value = MagicMock()
-        mock_dispatcher_cls.return_value.parse.return_value = _make_file_report()
+        mock_dispatcher_cls.return_value.parse_many.return_value = [_make_file_report()]
 
         # Git modules
         mock_harvester_cls.return_value.harvest.return_value = []
@@ -273,7 +275,7 @@ class TestGitAnalysisWarning:
 
             mock_scanner_cls.return_value.scan.return_value = [tmp_path / "test.py"]
             mock_registry.return_value = MagicMock()
-            mock_dispatcher_cls.return_value.parse.return_value = _make_file_report()
+            mock_dispatcher_cls.return_value.parse_many.return_value = [_make_file_report()]
 
             # Simulate git failure
             mock_harvester_cls.return_value.harvest.side_effect = subprocess.CalledProcessError(
@@ -316,7 +318,7 @@ class TestGitAnalysisWarning:
 
             mock_scanner_cls.return_value.scan.return_value = [tmp_path / "test.py"]
             mock_registry.return_value = MagicMock()
-            mock_dispatcher_cls.return_value.parse.return_value = _make_file_report()
+            mock_dispatcher_cls.return_value.parse_many.return_value = [_make_file_report()]
 
             # Simulate git binary not found
             mock_harvester_cls.return_value.harvest.side_effect = OSError("git not found")
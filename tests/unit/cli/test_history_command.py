@@ -0,0 +1,31 @@
+"""Unit tests for the ``history`` CLI command."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from typer.testing import CliRunner
+
+from dev_stats.cli.app import app
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+runner = CliRunner()
+
+
+class TestHistoryCommand:
+    """Tests for ``dev-stats history``."""
+
+    def test_lists_each_commit(self, fake_repo: Path) -> None:
+        """One row per commit declaring the symbol."""
+        result = runner.invoke(app, ["history", "greet", str(fake_repo)])
+        assert result.exit_code == 0, result.output
+        assert "History of greet" in result.output
+        assert result.output.count("hello.py") == 2
+
+    def test_unknown_symbol_fails(self, fake_repo: Path) -> None:
+        """A symbol never declared exits with code 1."""
+        result = runner.invoke(app, ["history", "nope", str(fake_repo)])
+        assert result.exit_code == 1
+        assert "No commits declare nope" in result.output
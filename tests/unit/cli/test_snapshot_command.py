@@ -0,0 +1,56 @@
+"""Unit tests for the ``snapshot`` CLI commands."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from typer.testing import CliRunner
+
+from dev_stats.cli.app import app
+from dev_stats.core.snapshot_store import SnapshotStore
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+runner = CliRunner()
+
+
+class TestSnapshotCommand:
+    """Tests for ``dev-stats snapshot save`` and ``snapshot load``."""
+
+    def test_save_then_load_binary(self, fake_repo: Path, tmp_path: Path) -> None:
+        """A binary snapshot of the repository loads back with its files."""
+        out = tmp_path / "out" / "snap.dss"
+        result = runner.invoke(app, ["snapshot", "save", str(out), str(fake_repo)])
+        assert result.exit_code == 0, result.output
+        assert SnapshotStore.is_binary(out)
+        assert [f.path.name for f in SnapshotStore().load(out).files] == ["hello.py"]
+
+        result = runner.invoke(app, ["snapshot", "load", str(out), "--show"])
+        assert result.exit_code == 0, result.output
+        assert "1 file(s)" in result.output
+        assert "hello.py" in result.output
+
+    def test_save_json(self, fake_repo: Path, tmp_path: Path) -> None:
+        """``--json`` writes the JSON encoding, which load also accepts."""
+        out = tmp_path / "snap.json"
+        result = runner.invoke(app, ["snapshot", "save", str(out), str(fake_repo), "--json"])
+        assert result.exit_code == 0, result.output
+        assert not SnapshotStore.is_binary(out)
+
+        result = runner.invoke(app, ["snapshot", "load", str(out)])
+        assert result.exit_code == 0, result.output
+        assert "hello.py" not in result.output
+
+    def test_load_bad_version_fails(self, tmp_path: Path) -> None:
+        """A snapshot with a foreign version byte exits with code 1."""
+        path = tmp_path / "snap.dss"
+        path.write_bytes(b"DSST\xff" + b"\x00" * 8)
+        result = runner.invoke(app, ["snapshot", "load", str(path)])
+        assert result.exit_code == 1
+        assert "unsupported snapshot version" in result.output
+
+    def test_load_missing_file_fails(self, tmp_path: Path) -> None:
+        """A missing snapshot exits with code 1."""
+        result = runner.invoke(app, ["snapshot", "load", str(tmp_path / "none.dss")])
+        assert result.exit_code == 1
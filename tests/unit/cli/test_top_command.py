@@ -0,0 +1,31 @@
+"""Unit tests for the ``top`` CLI command."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from typer.testing import CliRunner
+
+from dev_stats.cli.app import app
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+runner = CliRunner()
+
+
+class TestTopCommand:
+    """Tests for ``dev-stats top``."""
+
+    def test_lists_functions(self, fake_repo: Path) -> None:
+        """The repository's function is listed with its metric value."""
+        result = runner.invoke(app, ["top", str(fake_repo), "--metric", "cc", "--n", "10"])
+        assert result.exit_code == 0, result.output
+        assert "greet" in result.output
+        assert "hello.py" in result.output
+
+    def test_unknown_metric_fails(self, fake_repo: Path) -> None:
+        """An unsupported metric exits with code 1."""
+        result = runner.invoke(app, ["top", str(fake_repo), "--metric", "bogus"])
+        assert result.exit_code == 1
+        assert "unknown metric" in result.output
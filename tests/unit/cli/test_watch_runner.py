@@ -2,14 +2,12 @@
 
 from __future__ import annotations
 
-from typing import TYPE_CHECKING
+import threading
+from pathlib import Path
 from unittest.mock import MagicMock, patch
 
 import pytest
 
-if TYPE_CHECKING:
-    from pathlib import Path
-
 
 class TestWatchRunner:
     """Tests for WatchRunner."""
@@ -78,3 +76,68 @@ class TestWatchRunner:
 
         # Initial analysis should have been called
         mock_analysis.assert_called_once()
+
+    def test_on_changes_receives_batch(self, tmp_path: Path) -> None:
+        """With on_changes, each batch is passed on instead of re-running."""
+        from dev_stats.cli.watch_runner import WatchRunner
+
+        mock_analysis = MagicMock()
+        batches: list[set[Path]] = []
+        changed = str(tmp_path / "a.go")
+
+        def fake_watch(*_args: object, **_kwargs: object) -> list[set[tuple[int, str]]]:
+            """Yield a single batch of changes."""
+            return [{(2, changed)}]
+
+        runner = WatchRunner(
+            repo_path=tmp_path, run_analysis=mock_analysis, on_changes=batches.append
+        )
+        mock_watchfiles = MagicMock()
+        mock_watchfiles.watch = fake_watch
+        with patch.dict("sys.modules", {"watchfiles": mock_watchfiles}):
+            runner.run()
+
+        assert batches == [{Path(changed)}]
+        mock_analysis.assert_called_once()
+
+    def test_start_import_error_without_watchfiles(self, tmp_path: Path) -> None:
+        """WatchRunner.start fails synchronously when watchfiles is missing."""
+        from dev_stats.cli.watch_runner import WatchRunner
+
+        runner = WatchRunner(repo_path=tmp_path, run_analysis=lambda: None)
+        with (
+            patch.dict("sys.modules", {"watchfiles": None}),
+            pytest.raises(ImportError, match="watchfiles"),
+        ):
+            runner.start()
+
+
+class TestWatchRunnerIntegration:
+    """Real filesystem events (needs ``watchfiles``)."""
+
+    def test_on_changes_fires_within_500ms(self, tmp_path: Path) -> None:
+        """Saving a file triggers on_changes within 500 ms; stop ends the watch."""
+        pytest.importorskip("watchfiles")
+        from dev_stats.cli.watch_runner import WatchRunner
+
+        target = tmp_path / "main.go"
+        target.write_text("package main\n", encoding="utf-8")
+        fired = threading.Event()
+        seen: list[set[Path]] = []
+
+        def on_changes(paths: set[Path]) -> None:
+            """Record the batch and signal the test thread."""
+            seen.append(paths)
+            fired.set()
+
+        runner = WatchRunner(repo_path=tmp_path, run_analysis=lambda: None, on_changes=on_changes)
+        stop = runner.start()
+        try:
+            # Give the OS watcher a moment to register before writing.
+            threading.Event().wait(0.1)
+            target.write_text("package main\n\nfunc main() {}\n", encoding="utf-8")
+            assert fired.wait(0.5)
+        finally:
+            stop()
+
+        assert any(p.name == "main.go" for batch in seen for p in batch)
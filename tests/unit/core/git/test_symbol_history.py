@@ -0,0 +1,55 @@
+"""Unit tests for SymbolHistory."""
+
+from __future__ import annotations
+
+import subprocess
+from typing import TYPE_CHECKING
+
+from dev_stats.core.git.symbol_history import SymbolHistory
+from dev_stats.core.parser_registry import create_default_registry
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+
+def _commit(repo: Path, message: str) -> None:
+    """Stage everything in *repo* and commit it."""
+    for args in (("add", "-A"), ("commit", "-q", "-m", message)):
+        subprocess.run(["git", *args], cwd=repo, check=True, capture_output=True, timeout=30)
+
+
+class TestSymbolHistory:
+    """Tests for per-commit symbol metrics."""
+
+    def test_tracks_metric_changes(self, fake_repo: Path) -> None:
+        """Every commit declaring the symbol yields a point, oldest first."""
+        (fake_repo / "hello.py").write_text(
+            '"""Hello."""\n\ndef greet(name: str = "") -> str:\n'
+            '    if name:\n        return f"hi {name}"\n    return "hi"\n'
+        )
+        _commit(fake_repo, "branch on empty name")
+
+        points = SymbolHistory(fake_repo, create_default_registry()).history("greet")
+
+        assert [p.metrics.cyclomatic_complexity for p in points] == [1, 1, 2]
+        assert [p.metrics.lines for p in points] == [2, 2, 4]
+        assert {p.path for p in points} == {"hello.py"}
+        assert points[0].timestamp <= points[-1].timestamp
+        assert len({p.sha for p in points}) == 3
+
+    def test_skips_commits_without_symbol(self, fake_repo: Path) -> None:
+        """A symbol added later only appears from that commit on."""
+        (fake_repo / "extra.py").write_text("def wave() -> None:\n    pass\n")
+        _commit(fake_repo, "add wave")
+
+        history = SymbolHistory(fake_repo, create_default_registry())
+
+        assert len(history.history("wave")) == 1
+        assert history.history("missing") == []
+        assert len(history.history("greet", max_commits=1)) == 1
+
+    def test_working_tree_untouched(self, fake_repo: Path) -> None:
+        """History is read from git objects, not by checking out commits."""
+        (fake_repo / "hello.py").write_text("def greet():\n    return 1\n")
+        SymbolHistory(fake_repo, create_default_registry()).history("greet")
+        assert (fake_repo / "hello.py").read_text() == "def greet():\n    return 1\n"
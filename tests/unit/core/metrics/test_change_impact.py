@@ -0,0 +1,150 @@
+"""Unit tests for ChangeImpactAnalyser."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.metrics.change_impact import ChangeImpactAnalyser
+from dev_stats.core.models import CallGraph, FileReport
+from dev_stats.core.parsers.go_parser import GoParser
+
+_CHAIN = """\
+package chain
+
+func A() {
+    B()
+}
+
+func B() {
+    C()
+}
+
+func C() {}
+
+func D() {
+    A()
+    C()
+}
+"""
+
+
+_CHAIN_KEY = "(root) [chain]"
+
+
+def _parse(source: str, name: str = "chain.go") -> FileReport:
+    """Parse Go *source* as a file called *name*."""
+    return GoParser().parse_bytes(source.encode(), Path(name))
+
+
+def _names(*names: str) -> tuple[str, ...]:
+    """Qualify *names* with the ``chain`` package at the root."""
+    return tuple(f"{_CHAIN_KEY}.{name}" for name in names)
+
+
+class TestChangeImpact:
+    """Tests for the reverse call-graph walk."""
+
+    def test_direct_and_transitive_callers(self) -> None:
+        """B and D call C directly; A reaches it through B."""
+        report = ChangeImpactAnalyser().analyse([_parse(_CHAIN)], "C")
+        assert report.symbol == "C"
+        assert report.direct_callers == _names("B", "D")
+        assert report.transitive_callers == _names("A")
+        assert report.max_depth == 2
+        assert report.total_impacted == 3
+
+    def test_callers_listed_once_at_nearest_distance(self) -> None:
+        """D calls C directly and through A; it is only a direct caller."""
+        report = ChangeImpactAnalyser().analyse([_parse(_CHAIN)], "C")
+        assert _names("D")[0] not in report.transitive_callers
+
+    def test_depth_limit(self) -> None:
+        """max_depth=1 stops at direct callers."""
+        report = ChangeImpactAnalyser(max_depth=1).analyse([_parse(_CHAIN)], "C")
+        assert report.direct_callers == _names("B", "D")
+        assert report.transitive_callers == ()
+        assert report.max_depth == 1
+
+    def test_invalid_depth(self) -> None:
+        """A depth below one is rejected."""
+        with pytest.raises(ValueError, match="max_depth"):
+            ChangeImpactAnalyser(max_depth=0)
+
+    def test_uncalled_and_unknown_symbols(self) -> None:
+        """A function nobody calls, or an unknown name, has no impact."""
+        for symbol in ("D", "Missing"):
+            report = ChangeImpactAnalyser().analyse([_parse(_CHAIN)], symbol)
+            assert report.total_impacted == 0
+            assert report.max_depth == 0
+
+    def test_cycle_terminates(self) -> None:
+        """Mutual recursion is walked once per function."""
+        src = "package p\n\nfunc Ping() {\n    Pong()\n}\n\nfunc Pong() {\n    Ping()\n}\n"
+        report = ChangeImpactAnalyser().analyse([_parse(src)], "Ping")
+        assert report.direct_callers == ("(root) [p].Pong",)
+        assert report.transitive_callers == ()
+
+    def test_package_filter(self) -> None:
+        """Only files of the requested package are merged."""
+        other = _parse("package other\n\nfunc E() {\n    C()\n}\n\nfunc C() {}\n", "other.go")
+        files = [_parse(_CHAIN), other]
+        assert ChangeImpactAnalyser().analyse(files, "C").direct_callers == (
+            *_names("B", "D"),
+            "(root) [other].E",
+        )
+        report = ChangeImpactAnalyser().analyse(files, "C", package="chain")
+        assert report.direct_callers == _names("B", "D")
+
+    def test_callers_in_sibling_files(self) -> None:
+        """Calls to a function declared in another file of the package are followed."""
+        caller = _parse("package chain\n\nfunc E() {\n    F()\n}\n", "e.go")
+        callee = _parse("package chain\n\nfunc F() {}\n", "f.go")
+        stranger = _parse("package other\n\nfunc G() {\n    F()\n}\n", "g.go")
+        report = ChangeImpactAnalyser().analyse([caller, callee, stranger], "F")
+        assert report.direct_callers == _names("E")
+
+    def test_same_package_name_in_two_directories(self) -> None:
+        """Two ``main`` packages in different directories are not merged."""
+        caller = _parse("package main\n\nfunc main() {\n    helper()\n}\n", "cmd/a/main.go")
+        callee = _parse(
+            "package main\n\nfunc helper() {}\n\nfunc run() {\n    helper()\n}\n",
+            "cmd/b/util.go",
+        )
+        report = ChangeImpactAnalyser().analyse([caller, callee], "helper")
+        assert report.direct_callers == ("cmd/b [main].run",)
+
+    def test_qualified_symbol_picks_one_package(self) -> None:
+        """A ``dir [package].name`` symbol is looked up in that package only."""
+        other = _parse("package other\n\nfunc E() {\n    C()\n}\n\nfunc C() {}\n", "other.go")
+        files = [_parse(_CHAIN), other]
+        report = ChangeImpactAnalyser().analyse(files, "(root) [other].C")
+        assert report.direct_callers == ("(root) [other].E",)
+
+
+class TestMergeGraphs:
+    """Tests for combining per-file call graphs."""
+
+    def test_union_of_nodes_and_edges(self) -> None:
+        """Nodes and edges are de-duplicated and sorted."""
+        merged = ChangeImpactAnalyser.merge_graphs(
+            [
+                CallGraph(nodes=("a", "b"), edges=(("a", "b"),)),
+                CallGraph(nodes=("b", "c"), edges=(("a", "b"), ("c", "b"))),
+            ]
+        )
+        assert merged.nodes == ("a", "b", "c")
+        assert merged.edges == (("a", "b"), ("c", "b"))
+        assert merged.callers("b") == ["a", "c"]
+
+    def test_unresolved_calls_linked(self) -> None:
+        """Unresolved calls become edges once their callee is merged in."""
+        merged = ChangeImpactAnalyser.merge_graphs(
+            [
+                CallGraph(nodes=("a",), unresolved=(("a", "b"), ("a", "len"))),
+                CallGraph(nodes=("b",)),
+            ]
+        )
+        assert merged.edges == (("a", "b"),)
+        assert merged.unresolved == (("a", "len"),)
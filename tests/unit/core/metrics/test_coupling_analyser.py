@@ -2,10 +2,11 @@
 
 from __future__ import annotations
 
+import math
 from pathlib import Path
 
 from dev_stats.core.metrics.coupling_analyser import CouplingAnalyser
-from dev_stats.core.models import ClassReport, FileReport
+from dev_stats.core.models import ClassReport, FileReport, ImportGraph
 
 
 def _make_file(
@@ -103,3 +104,83 @@ class TestCouplingAnalyser:
         report = analyser.analyse(files)
         for mod in report.modules:
             assert 0.0 <= mod.distance <= 1.0
+
+
+class TestImportGraph:
+    """Tests for the module-level import graph."""
+
+    def test_three_package_cycle_detected(self) -> None:
+        """A -> B -> C -> A is reported as one ordered cycle."""
+        files = [
+            _make_file("pkg/beta/b.go", imports=("gamma",)),
+            _make_file("pkg/alpha/a.go", imports=("beta",)),
+            _make_file("pkg/gamma/c.go", imports=("alpha",)),
+        ]
+        graph = CouplingAnalyser().build_import_graph(files)
+        assert graph.cycles() == [["pkg/alpha", "pkg/beta", "pkg/gamma"]]
+
+    def test_acyclic_graph_has_no_cycles(self) -> None:
+        """A chain of imports contains no cycle."""
+        files = [
+            _make_file("app/main.py", imports=("core",)),
+            _make_file("lib/core.py", imports=("util",)),
+            _make_file("util/util.py"),
+        ]
+        graph = CouplingAnalyser().build_import_graph(files)
+        assert graph.edges == (("app", "lib"), ("lib", "util"))
+        assert graph.cycles() == []
+
+    def test_fan_in_fan_out(self) -> None:
+        """Afferent and efferent counts come from the edges."""
+        files = [
+            _make_file("a/x.py", imports=("core",)),
+            _make_file("b/y.py", imports=("core",)),
+            _make_file("core/core.py"),
+        ]
+        graph = CouplingAnalyser().build_import_graph(files)
+        assert graph.afferent("core") == 2
+        assert graph.efferent("core") == 0
+        assert graph.efferent("a") == 1
+
+    def test_graph_attached_to_report(self) -> None:
+        """analyse() exposes the graph on the coupling report."""
+        files = [_make_file("app/main.py", imports=("core",)), _make_file("lib/core.py")]
+        report = CouplingAnalyser().analyse(files)
+        assert report.import_graph is not None
+        assert report.import_graph.nodes == ("app", "lib")
+
+
+class TestPackageMetrics:
+    """Tests for graph-based package metrics, abstractness and distance."""
+
+    def test_three_package_instability(self) -> None:
+        """api -> svc -> store and api -> store give known I values."""
+        graph = ImportGraph(
+            nodes=("api", "store", "svc"),
+            edges=(("api", "store"), ("api", "svc"), ("svc", "store")),
+        )
+        metrics = CouplingAnalyser().package_coupling(graph)
+
+        assert (metrics["api"].afferent, metrics["api"].efferent) == (0, 2)
+        assert (metrics["svc"].afferent, metrics["svc"].efferent) == (1, 1)
+        assert (metrics["store"].afferent, metrics["store"].efferent) == (2, 0)
+        assert math.isclose(metrics["api"].instability, 1.0)
+        assert math.isclose(metrics["svc"].instability, 0.5)
+        assert math.isclose(metrics["store"].instability, 0.0)
+        assert math.isclose(metrics["store"].distance, 1.0)
+
+    def test_go_interfaces_are_abstract(self) -> None:
+        """Interfaces count towards abstractness alongside concrete structs."""
+        iface = ClassReport(name="Store", line=1, end_line=3, lines=3, decorators=("interface",))
+        structs = tuple(
+            ClassReport(name=n, line=5, end_line=6, lines=2, decorators=("struct",))
+            for n in ("Mem", "Disk", "Cache")
+        )
+        files = [_make_file("store/store.go", classes=(iface, *structs))]
+        assert math.isclose(CouplingAnalyser.abstractness(files), 0.25)
+        assert CouplingAnalyser.abstractness([_make_file("x/x.go")]) == 0.0
+
+    def test_distance_from_main_sequence(self) -> None:
+        """D = |A + I - 1|."""
+        assert math.isclose(CouplingAnalyser.distance_from_main_sequence(0.5, 0.25), 0.25)
+        assert math.isclose(CouplingAnalyser.distance_from_main_sequence(1.0, 1.0), 1.0)
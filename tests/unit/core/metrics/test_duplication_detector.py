@@ -5,7 +5,8 @@ from __future__ import annotations
 from pathlib import Path
 
 from dev_stats.core.metrics.duplication_detector import DuplicationDetector
-from dev_stats.core.models import FileReport
+from dev_stats.core.models import ClassReport, FileReport, MethodReport
+from dev_stats.core.parsers.go_parser import GoParser
 
 
 class TestDuplicationDetector:
@@ -150,3 +151,59 @@ class TestDuplicationDetectorDetect:
         report = detector.detect([])
         assert report.duplication_ratio == 0.0
         assert report.total_duplicated_lines == 0
+
+
+class TestFindDuplicateFunctions:
+    """Tests for body-hash function grouping."""
+
+    def test_copies_in_different_files(self, tmp_path: Path) -> None:
+        """Identically structured functions in two files form one group."""
+        (tmp_path / "a.go").write_text(
+            "package a\n\nfunc Clamp(v, lo, hi int) int {\n\tif v < lo {\n\t\treturn lo\n"
+            "\t}\n\tif v > hi {\n\t\treturn hi\n\t}\n\treturn v\n}\n"
+        )
+        (tmp_path / "b.go").write_text(
+            "package b\n\ntype Range struct{}\n\n"
+            "func (r Range) Bound(x, min, max int) int {\n\tif x < min {\n\t\treturn min\n"
+            "\t}\n\tif x > max {\n\t\treturn max\n\t}\n\treturn x\n}\n\n"
+            "func Other(x int) int {\n\tif x < 0 {\n\t\treturn -x\n\t}\n\treturn x\n}\n"
+        )
+        parser = GoParser()
+        files = [parser.parse(tmp_path / name, tmp_path) for name in ("a.go", "b.go")]
+
+        groups = DuplicationDetector(min_lines=3).find_duplicate_functions(files)
+
+        assert len(groups) == 1
+        assert groups[0].functions == ("(root) [a].Clamp", "(root) [b].Range.Bound")
+
+    def test_same_package_name_in_two_directories(self, tmp_path: Path) -> None:
+        """Copies in two ``main`` packages keep their directories apart."""
+        body = (
+            "package main\n\nfunc run(v, lo int) int {\n\tif v < lo {\n\t\treturn lo\n"
+            "\t}\n\treturn v\n}\n"
+        )
+        for directory in ("cmd/a", "cmd/b"):
+            (tmp_path / directory).mkdir(parents=True)
+            (tmp_path / directory / "main.go").write_text(body)
+        parser = GoParser()
+        files = [parser.parse(tmp_path / d / "main.go", tmp_path) for d in ("cmd/a", "cmd/b")]
+
+        groups = DuplicationDetector(min_lines=3).find_duplicate_functions(files)
+
+        assert [g.functions for g in groups] == [("cmd/a [main].run", "cmd/b [main].run")]
+
+    def test_short_and_unhashed_functions_skipped(self) -> None:
+        """Functions below min_lines or without a hash never group."""
+        short = MethodReport(name="Get", line=1, end_line=1, lines=1, body_hash="h")
+        unhashed = MethodReport(name="Big", line=1, end_line=10, lines=10)
+        f = FileReport(
+            path=Path("x.py"),
+            language="python",
+            total_lines=10,
+            code_lines=10,
+            blank_lines=0,
+            comment_lines=0,
+            functions=(short, unhashed),
+            classes=(ClassReport(name="C", line=1, end_line=2, lines=2, methods=(short,)),),
+        )
+        assert DuplicationDetector(min_lines=3).find_duplicate_functions([f]) == ()
@@ -0,0 +1,110 @@
+"""Unit tests for ReportCardGrader."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.metrics.report_card import AGGREGATE_KEY, ReportCardGrader, grade_for
+from dev_stats.core.models import FileReport, MethodReport, RepoReport
+from dev_stats.core.parsers.go_parser import GoParser
+
+_FIXTURE = Path(__file__).resolve().parents[3] / "fixtures" / "sample_files" / "go" / "sample.go"
+
+
+def _bad_file() -> FileReport:
+    """Build an undocumented Go file whose functions are all highly complex."""
+    return FileReport(
+        path=Path("bad.go"),
+        language="go",
+        total_lines=120,
+        code_lines=110,
+        blank_lines=10,
+        comment_lines=0,
+        functions=(
+            MethodReport(name="Process", line=1, end_line=60, lines=60, cyclomatic_complexity=18),
+            MethodReport(name="Handle", line=61, end_line=120, lines=60, cyclomatic_complexity=14),
+        ),
+    )
+
+
+class TestGradeFor:
+    """Tests for the score-to-grade mapping."""
+
+    def test_band_edges(self) -> None:
+        """Each band starts at its floor; below 60 is an F."""
+        assert grade_for(100.0) == "A"
+        assert grade_for(90.0) == "A"
+        assert grade_for(89.9) == "B"
+        assert grade_for(70.0) == "C"
+        assert grade_for(60.0) == "D"
+        assert grade_for(59.9) == "F"
+
+
+class TestGradeFile:
+    """Tests for per-file grading."""
+
+    def test_fixture_earns_at_least_b(self) -> None:
+        """The well-documented, simple sample file grades A or B."""
+        card = ReportCardGrader().grade_file(GoParser().parse(_FIXTURE, _FIXTURE.parent))
+        assert card.grade in {"A", "B"}
+        assert card.score >= 80.0
+
+    def test_bad_file_earns_d_or_lower(self) -> None:
+        """High complexity and no doc comments grade D or F."""
+        card = ReportCardGrader().grade_file(_bad_file())
+        assert card.grade in {"D", "F"}
+
+    def test_breakdown_sums_to_score(self) -> None:
+        """Components appear in weighting order and add up to the score."""
+        card = ReportCardGrader().grade_file(GoParser().parse(_FIXTURE, _FIXTURE.parent))
+        assert [name for name, _ in card.breakdown] == [
+            "complexity",
+            "maintainability",
+            "documentation",
+            "size",
+        ]
+        assert sum(card.contributions.values()) == pytest.approx(card.score)
+
+    def test_missing_maintainability_reweights(self) -> None:
+        """Without Halstead data the other weights are scaled up to sum to 1."""
+        card = ReportCardGrader().grade_file(_bad_file())
+        contributions = card.contributions
+        assert "maintainability" not in contributions
+        # documentation 0, complexity 0, size 100 at weight 0.15 / 0.70
+        assert contributions["size"] == pytest.approx(100.0 * 0.15 / 0.70)
+        assert card.score == pytest.approx(contributions["size"])
+
+    def test_empty_file_is_perfect(self) -> None:
+        """A file with nothing to criticise scores 100."""
+        empty = FileReport(
+            path=Path("doc.go"),
+            language="go",
+            total_lines=1,
+            code_lines=1,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        card = ReportCardGrader().grade_file(empty)
+        assert card.score == pytest.approx(100.0)
+        assert card.grade == "A"
+
+
+class TestGradeRepo:
+    """Tests for repository grading."""
+
+    def test_per_file_and_aggregate(self) -> None:
+        """Every file is graded and the aggregate pools them."""
+        good = GoParser().parse(_FIXTURE, _FIXTURE.parent)
+        report = RepoReport(root=_FIXTURE.parent, files=(good, _bad_file()))
+        cards = ReportCardGrader().grade_repo(report)
+
+        assert set(cards) == {"sample.go", "bad.go", AGGREGATE_KEY}
+        assert cards["sample.go"].score > cards[AGGREGATE_KEY].score > cards["bad.go"].score
+
+    def test_empty_repo(self) -> None:
+        """An empty report yields only a perfect aggregate."""
+        cards = ReportCardGrader().grade_repo(RepoReport(root=Path(".")))
+        assert list(cards) == [AGGREGATE_KEY]
+        assert cards[AGGREGATE_KEY].grade == "A"
@@ -4,7 +4,10 @@ from __future__ import annotations
 
 from pathlib import Path
 
+import pytest
+
 from dev_stats.core.metrics.test_coverage_reader import TestCoverageReader
+from dev_stats.core.models import ClassReport, CoverBlock, CoverProfile, FileReport, MethodReport
 
 
 class TestCoverageReaderNoFile:
@@ -261,3 +264,106 @@ class TestCoverageReaderLcovEdgeCases:
         report = reader.read(tmp)
         assert len(report.files) == 1
         assert report.files[0].coverage_ratio == 0.0
+
+
+_PROFILE = """\
+mode: set
+example.com/calc/calc.go:30.2,45.16 5 1
+example.com/calc/calc.go:50.10,60.2 3 0
+example.com/calc/other.go:1.1,9.2 2 1
+"""
+
+
+def _calc_file() -> FileReport:
+    """Build a Go file whose functions span fixed line ranges."""
+
+    def func(name: str, line: int, end_line: int) -> MethodReport:
+        """Create a function spanning *line* to *end_line*."""
+        return MethodReport(name=name, line=line, end_line=end_line, lines=end_line - line + 1)
+
+    cls = ClassReport(name="Calc", line=20, end_line=48, lines=29, methods=(func("Add", 25, 35),))
+    return FileReport(
+        path=Path("calc.go"),
+        language="go",
+        total_lines=70,
+        code_lines=60,
+        blank_lines=10,
+        comment_lines=0,
+        package="calc",
+        functions=(func("Setup", 1, 20), func("Run", 40, 50), func("Close", 50, 60)),
+        classes=(cls,),
+    )
+
+
+class TestCoverageReaderGoProfile:
+    """Tests for ``go test -coverprofile`` parsing and function annotation."""
+
+    def test_load_cover_profile(self, tmp_path: Path) -> None:
+        """Every block line is parsed with its position and count."""
+        path = tmp_path / "cover.out"
+        path.write_text(_PROFILE)
+        profile = TestCoverageReader.load_cover_profile(path)
+        assert profile.mode == "set"
+        assert len(profile.blocks) == 3
+        assert profile.blocks[0] == CoverBlock(
+            file="example.com/calc/calc.go",
+            start_line=30,
+            start_column=2,
+            end_line=45,
+            end_column=16,
+            statements=5,
+            count=1,
+        )
+        assert len(profile.blocks_for(Path("calc.go"), "example.com/calc")) == 2
+
+    def test_blocks_matched_by_exact_path(self) -> None:
+        """A root ``main.go`` does not take the blocks of ``cmd/main.go``."""
+        root = CoverBlock("example.com/app/main.go", 1, 1, 2, 2, 1, 1)
+        nested = CoverBlock("example.com/app/cmd/main.go", 3, 1, 4, 2, 1, 0)
+        profile = CoverProfile(mode="set", blocks=(root, nested))
+        assert profile.blocks_for(Path("main.go"), "example.com/app") == [root]
+        assert profile.blocks_for(Path("cmd/main.go"), "example.com/app") == [nested]
+        assert profile.blocks_for(Path("main.go")) == []
+
+    def test_functions_marked_by_line_range(self, tmp_path: Path) -> None:
+        """Functions overlapping covered lines 30-45 are covered, the rest not."""
+        path = tmp_path / "cover.out"
+        path.write_text(_PROFILE)
+        profile = TestCoverageReader.load_cover_profile(path)
+        report = TestCoverageReader.annotate_with_coverage(
+            _calc_file(), profile, "example.com/calc"
+        )
+
+        covered = {name: f.covered for name, f in report.qualified_functions}
+        assert covered == {"Setup": False, "Run": True, "Close": False, "Calc.Add": True}
+        assert report.uncovered_functions == ["Setup", "Close"]
+        assert [fs.covered for fs in report.function_stats] == [False, True, False, True]
+
+    def test_unlisted_file_unchanged(self) -> None:
+        """A file the profile does not mention keeps ``covered`` unknown."""
+        file = _calc_file()
+        report = TestCoverageReader.annotate_with_coverage(file, CoverProfile(mode="set"))
+        assert report is file
+        assert report.uncovered_functions == []
+
+    def test_merged_headers_and_blank_lines(self, tmp_path: Path) -> None:
+        """Repeated ``mode:`` lines from merged profiles are skipped."""
+        path = tmp_path / "cover.out"
+        path.write_text("mode: count\na/b.go:1.1,2.2 1 4\n\nmode: count\na/c.go:3.1,4.2 1 0\n")
+        profile = TestCoverageReader.load_cover_profile(path)
+        assert profile.mode == "count"
+        assert [b.count for b in profile.blocks] == [4, 0]
+
+    def test_missing_header_rejected(self, tmp_path: Path) -> None:
+        """A file without the ``mode:`` header is not a cover profile."""
+        path = tmp_path / "cover.out"
+        path.write_text("example.com/calc/calc.go:30.2,45.16 5 1\n")
+        with pytest.raises(ValueError, match="missing 'mode:' line"):
+            TestCoverageReader.load_cover_profile(path)
+
+    def test_malformed_line_rejected(self, tmp_path: Path) -> None:
+        """A block line that does not parse names its line number."""
+        path = tmp_path / "cover.out"
+        path.write_text("mode: set\ncalc.go:30,45 5 1\n")
+        with pytest.raises(ValueError, match=r"cover.out:2: malformed"):
+            TestCoverageReader.load_cover_profile(path)
@@ -0,0 +1,97 @@
+"""Unit tests for TopNRanker."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.metrics.top_n import METRICS, TopNRanker
+from dev_stats.core.models import ClassReport, FileReport, MethodReport, RepoReport
+
+# CC values of 20 functions spread over two files, highest (19) in the second.
+_CCS = (3, 7, 1, 12, 5, 9, 2, 15, 4, 8, 6, 11, 19, 10, 1, 14, 3, 13, 2, 7)
+
+
+def _func(name: str, cc: int = 1, **kwargs: object) -> MethodReport:
+    """Create a MethodReport with the given complexity."""
+    return MethodReport(
+        name=name,
+        line=1,
+        end_line=5,
+        lines=5,
+        cyclomatic_complexity=cc,
+        **kwargs,  # type: ignore[arg-type]
+    )
+
+
+def _file(path: str, functions: tuple[MethodReport, ...], **kwargs: object) -> FileReport:
+    """Create a Go FileReport holding *functions*."""
+    return FileReport(
+        path=Path(path),
+        language="go",
+        total_lines=100,
+        code_lines=80,
+        blank_lines=10,
+        comment_lines=10,
+        package="calc",
+        functions=functions,
+        **kwargs,  # type: ignore[arg-type]
+    )
+
+
+def _report() -> RepoReport:
+    """Build a repository with the 20 functions of ``_CCS``."""
+    funcs = [_func(f"F{i}", cc) for i, cc in enumerate(_CCS)]
+    return RepoReport(
+        root=Path("."),
+        files=(_file("a.go", tuple(funcs[:10])), _file("b.go", tuple(funcs[10:]))),
+    )
+
+
+class TestTopNRanker:
+    """Tests for the repository-wide ranking."""
+
+    def test_top_five_by_cc(self) -> None:
+        """Exactly five entries come back, highest complexity first."""
+        ranked = TopNRanker().rank(_report().files, "cc", 5)
+        assert len(ranked) == 5
+        assert [r.value for r in ranked] == [19.0, 15.0, 14.0, 13.0, 12.0]
+        top = ranked[0]
+        assert (top.package, top.file, top.symbol) == ("calc", Path("b.go"), "F12")
+
+    def test_n_larger_than_function_count(self) -> None:
+        """Asking for more entries than functions returns all of them, sorted."""
+        ranked = TopNRanker().rank(_report().files, "cc", 50)
+        assert len(ranked) == 20
+        assert [r.value for r in ranked] == sorted((float(cc) for cc in _CCS), reverse=True)
+
+    def test_ties_keep_source_order(self) -> None:
+        """Equal values are listed, and kept at the cut-off, in source order."""
+        funcs = tuple(_func(f"T{i}", 4) for i in range(4))
+        ranked = TopNRanker().rank([_file("t.go", funcs)], "cc", 2)
+        assert [r.symbol for r in ranked] == ["T0", "T1"]
+
+    def test_other_metrics(self) -> None:
+        """Every metric reads its own MethodReport field."""
+        func = _func("Deep", 2, cognitive_complexity=6, nesting_depth=3)
+        f = _file("d.go", (func,))
+        values = {m: TopNRanker().rank([f], m, 1)[0].value for m in METRICS}
+        assert values == {"cc": 2.0, "loc": 5.0, "cognitive": 6.0, "params": 0.0, "nesting": 3.0}
+
+    def test_methods_are_qualified(self) -> None:
+        """Methods rank alongside functions under ``Type.method``."""
+        cls = ClassReport(name="Calc", line=1, end_line=9, lines=9, methods=(_func("Add", 9),))
+        f = _file("m.go", (_func("Helper", 1),), classes=(cls,))
+        assert [r.symbol for r in TopNRanker().rank([f], "cc", 2)] == ["Calc.Add", "Helper"]
+
+    def test_invalid_arguments(self) -> None:
+        """Unknown metrics and non-positive n are rejected."""
+        with pytest.raises(ValueError, match="unknown metric"):
+            TopNRanker().rank(_report().files, "halstead", 5)
+        with pytest.raises(ValueError, match="at least 1"):
+            TopNRanker().rank(_report().files, "cc", 0)
+
+    def test_no_functions(self) -> None:
+        """A repository without functions yields an empty ranking."""
+        assert TopNRanker().rank([], "loc", 3) == []
@@ -2,19 +2,23 @@
 
 from __future__ import annotations
 
+import io
 from pathlib import Path
 from typing import TYPE_CHECKING
 from unittest.mock import patch
 
+from dev_stats.core.parser_registry import create_default_registry
 from dev_stats.core.parsers.abstract_parser import (
     AbstractParser,
     count_loc,
     count_todos,
+    decode_source,
     detect_encoding,
 )
 
 if TYPE_CHECKING:
     from dev_stats.core.models import ClassReport, MethodReport
+    from dev_stats.core.parser_registry import ParserRegistry
 
 
 class TestCountLoc:
@@ -42,6 +46,35 @@ class TestCountLoc:
         assert result.comment == 1
         assert result.code == 1
 
+    def test_block_comments(self) -> None:
+        """Lines inside ``/* */`` count as comments; blank lines stay blank."""
+        source = "/*\n * Header\n\n */\nint x = 1;\n/* one-liner */\n"
+        result = count_loc(source, ("//",), ("/*", "*/"))
+        assert result.total == 6
+        assert result.comment == 4
+        assert result.blank == 1
+        assert result.code == 1
+
+    def test_trailing_comments_are_code(self) -> None:
+        """Code followed by a comment counts as code."""
+        source = "int x = 1; // one\nint y = 2; /* two */\n"
+        result = count_loc(source, ("//",), ("/*", "*/"))
+        assert result.code == 2
+        assert result.comment == 0
+
+    def test_trailing_block_opens_comment(self) -> None:
+        """An unclosed trailing ``/*`` makes the following lines comments."""
+        source = "int x = 1; /* start\n   still comment\n*/ int y = 2;\n"
+        result = count_loc(source, ("//",), ("/*", "*/"))
+        assert result.code == 2
+        assert result.comment == 1
+
+    def test_block_markers_ignored_without_delimiters(self) -> None:
+        """Without block delimiters, ``/*`` lines are code."""
+        source = "/*\n comment\n*/\n"
+        result = count_loc(source, ("//",))
+        assert result.code == 3
+
 
 class TestCountTodos:
     """Tests for the count_todos utility."""
@@ -80,6 +113,19 @@ class TestDetectEncoding:
         assert detect_encoding(f) == "utf-8"
 
 
+class TestDecodeSource:
+    """Tests for decode_source()."""
+
+    def test_utf8_and_line_endings(self) -> None:
+        """UTF-8 is decoded and CRLF / CR become LF."""
+        assert decode_source("a\r\nb\rc\n".encode()) == "a\nb\nc\n"
+        assert decode_source("é".encode()) == "é"
+
+    def test_latin1_fallback(self) -> None:
+        """Invalid UTF-8 falls back to Latin-1 like detect_encoding."""
+        assert decode_source(b"caf\xe9") == "café"
+
+
 class ConcreteParser(AbstractParser):
     """Minimal concrete parser for testing AbstractParser."""
 
@@ -139,7 +185,7 @@ class TestAbstractParser:
         parser = ConcreteParser()
         f = tmp_path / "bad.test"
         f.write_text("content")
-        with patch.object(Path, "read_text", side_effect=OSError("denied")):
+        with patch.object(Path, "read_bytes", side_effect=OSError("denied")):
             report = parser.parse(f, tmp_path)
         assert report.total_lines == 0
         assert report.language == "test"
@@ -154,3 +200,49 @@ class TestAbstractParser:
         assert report.comment_lines == 1
         assert report.blank_lines == 1
         assert report.code_lines == 1
+
+    def test_parse_source_matches_parse(self, tmp_path: Path) -> None:
+        """parse_source gives the same report without touching disk."""
+        parser = ConcreteParser()
+        f = tmp_path / "hello.test"
+        f.write_text("# comment\ncode\n\n")
+        in_memory = parser.parse_source("# comment\ncode\n\n", Path("hello.test"))
+        assert in_memory == parser.parse(f, tmp_path)
+
+
+def _assert_fixtures_equivalent(registry: ParserRegistry) -> None:
+    """Check parse_bytes and parse_stream against parse for every sample file.
+
+    Args:
+        registry: Registry choosing the parser for each fixture.
+    """
+    fixtures = Path(__file__).resolve().parents[3] / "fixtures" / "sample_files"
+    samples = sorted(p for p in fixtures.rglob("*") if p.is_file())
+    assert samples
+    for sample in samples:
+        parser = registry.get_or_default(sample)
+        relative = sample.relative_to(fixtures)
+        from_disk = parser.parse(sample, fixtures)
+        assert parser.parse_bytes(sample.read_bytes(), relative) == from_disk, sample
+        with sample.open("rb") as stream:
+            assert parser.parse_stream(stream, relative) == from_disk, sample
+
+
+class TestInMemoryEntryPoints:
+    """parse_bytes and parse_stream agree with parse for every sample file."""
+
+    def test_fixtures_equivalent(self) -> None:
+        """Each sample fixture yields the same report from disk and from bytes."""
+        _assert_fixtures_equivalent(create_default_registry())
+
+    def test_fixtures_equivalent_regex_parsers(self) -> None:
+        """The regex parsers, not only the tree-sitter ones, agree as well."""
+        _assert_fixtures_equivalent(create_default_registry(use_tree_sitter=False))
+
+    def test_bytes_size_and_path(self) -> None:
+        """size_bytes counts raw bytes and the report uses the given path."""
+        data = b"# comment\r\ncode\r\n"
+        report = ConcreteParser().parse_stream(io.BytesIO(data), Path("virtual.test"))
+        assert report.path == Path("virtual.test")
+        assert report.size_bytes == len(data)
+        assert (report.total_lines, report.comment_lines, report.code_lines) == (2, 1, 1)
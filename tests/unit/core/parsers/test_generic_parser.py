@@ -123,3 +123,14 @@ class TestGenericParserCanParse:
         parser = GenericParser()
         assert parser.can_parse(Path("anything.xyz")) is True
         assert parser.can_parse(Path("no_extension")) is True
+
+
+class TestGenericParserSource:
+    """Tests for in-memory parsing."""
+
+    def test_parse_source_uses_path_language(self) -> None:
+        """The extension of the given path still selects the language."""
+        report = GenericParser().parse_source("# c\necho hi\n", Path("run.sh"))
+        assert report.language == "shell"
+        assert report.path == Path("run.sh")
+        assert (report.code_lines, report.comment_lines, report.size_bytes) == (1, 1, 12)
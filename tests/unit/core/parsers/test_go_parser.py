@@ -2,18 +2,36 @@
 
 from __future__ import annotations
 
+import math
 from pathlib import Path
 from typing import TYPE_CHECKING
 
-from dev_stats.core.parsers.go_parser import GoParser
+from dev_stats.core.models import ChannelOpKind, UsageKind, interface_matrix
+from dev_stats.core.parsers.go_parser import (
+    GoParser,
+    body_hash,
+    cognitive_complexity,
+    cyclomatic_complexity,
+    error_handling,
+    halstead,
+    nesting_depth,
+    normalize_body,
+    result_count,
+    symbol_usages,
+)
 
 if TYPE_CHECKING:
     from dev_stats.core.models import FileReport
 
+_FIXTURE = Path(__file__).resolve().parents[3] / "fixtures" / "sample_files" / "go" / "sample.go"
+
 
 def _parse_source(source: str, filename: str = "test.go") -> FileReport:
     """Parse a source string and return the FileReport.
 
+    Every call also checks that :meth:`GoParser.parse_bytes` produces the
+    same report as parsing the file from disk.
+
     Args:
         source: Go source code.
         filename: Filename to use.
@@ -26,8 +44,42 @@ def _parse_source(source: str, filename: str = "test.go") -> FileReport:
     test_file = tmp / filename
     test_file.write_text(source)
     parser = GoParser()
-    return parser.parse(test_file, tmp)
+    report = parser.parse(test_file, tmp)
+    assert parser.parse_bytes(source.encode(), Path(filename)) == report
+    return report
+
+
+def _parse_fixture() -> FileReport:
+    """Parse the Go sample fixture from disk and check it against ``parse_bytes``.
+
+    Returns:
+        The ``FileReport`` of ``sample.go``.
+    """
+    parser = GoParser()
+    report = parser.parse(_FIXTURE, _FIXTURE.parent)
+    assert parser.parse_bytes(_FIXTURE.read_bytes(), Path(_FIXTURE.name)) == report
+    return report
+
+
+_MIXED_RECEIVERS = """\
+package store
 
+type Store struct {
+    items map[string]int
+}
+
+func (s *Store) Put(k string, v int) {
+    s.items[k] = v
+}
+
+func (s Store) Get(k string) int {
+    return s.items[k]
+}
+
+func (s Store) Len() int {
+    return len(s.items)
+}
+"""
 
 class TestGoParserStructs:
     """Tests for struct extraction."""
@@ -92,6 +144,73 @@ class TestGoParserFunctions:
         assert "Helper" in names
 
 
+_TYPE_LITERAL_SIGNATURES = """\
+package main
+
+func Any(v interface{}) interface{} {
+    if v == nil {
+        return nil
+    }
+    return v
+}
+
+func Pair() struct{ A, B int } {
+    panic("todo")
+}
+
+func (s *Server) Options(o struct {
+    Verbose bool
+}) error {
+    if o.Verbose {
+        return nil
+    }
+    return nil
+}
+
+type Server struct{}
+"""
+
+
+class TestGoParserSignatureBraces:
+    """``interface{}`` and ``struct{...}`` in signatures do not open the body."""
+
+    def test_function_bodies(self) -> None:
+        """Function spans and complexity come from the real body."""
+        report = _parse_source(_TYPE_LITERAL_SIGNATURES)
+        spans = {f.name: (f.line, f.end_line, f.cyclomatic_complexity) for f in report.functions}
+        assert spans == {"Any": (3, 8, 2), "Pair": (10, 12, 1)}
+
+    def test_method_body(self) -> None:
+        """A multi-line ``struct`` parameter is skipped to reach the method body."""
+        report = _parse_source(_TYPE_LITERAL_SIGNATURES)
+        (method,) = report.classes[0].methods
+        assert (method.name, method.line, method.end_line) == ("Options", 14, 21)
+        assert method.cyclomatic_complexity == 2
+
+    def test_sites_attributed_to_function(self) -> None:
+        """Call sites in the body are attributed to the enclosing function."""
+        report = _parse_source(_TYPE_LITERAL_SIGNATURES)
+        assert [(p.function, p.line) for p in report.panic_sites] == [("Pair", 11)]
+
+
+class TestGoParserPackage:
+    """Tests for package clause detection."""
+
+    def test_package_detected(self) -> None:
+        """GoParser records the declared package."""
+        report = _parse_source("// Package calc adds.\npackage calc\n")
+        assert report.package == "calc"
+
+    def test_package_in_comment_ignored(self) -> None:
+        """A ``package`` word inside a comment is not the clause."""
+        report = _parse_source("/*\npackage fake\n*/\npackage real\n")
+        assert report.package == "real"
+
+    def test_missing_package(self) -> None:
+        """Files without a clause have no package."""
+        assert _parse_source("func F() {}\n").package is None
+
+
 class TestGoParserImports:
     """Tests for import detection."""
 
@@ -136,20 +255,1221 @@ class TestGoParserCC:
         branch = next(f for f in report.functions if f.name == "Branch")
         assert branch.cyclomatic_complexity >= 3
 
+    def test_early_returns_and_logical_operators(self) -> None:
+        """Each ``&&`` and ``||`` adds one; early returns add nothing."""
+        src = (
+            "package main\n\n"
+            "func Check(a, b, c bool) int {\n"
+            "    if a && b {\n"
+            "        return 1\n"
+            "    }\n"
+            "    if b || c {\n"
+            "        return 2\n"
+            "    }\n"
+            "    return 0\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        check = next(f for f in report.functions if f.name == "Check")
+        assert check.cyclomatic_complexity == 5
+
+    def test_range_loop_counts_once(self) -> None:
+        """A ``for ... range`` loop is a single branch."""
+        body = "\n    for _, v := range xs {\n        total += v\n    }\n"
+        assert cyclomatic_complexity(body) == 2
+
+    def test_switch_and_select_cases(self) -> None:
+        """Every ``case`` and the ``select`` keyword add one."""
+        body = (
+            "\n    switch x {\n    case 1:\n    case 2:\n    default:\n    }\n"
+            "    select {\n    case <-ch:\n    }\n"
+        )
+        assert cyclomatic_complexity(body) == 5
+
+    def test_comments_and_strings_ignored(self) -> None:
+        """Branch keywords in comments and literals do not count."""
+        body = (
+            "\n    // if this || that\n"
+            "    /* for case\n       select */\n"
+            '    msg := "if a && b"\n'
+            "    raw := `for || case`\n"
+            "    r := '|'\n"
+            "    return msg + raw\n"
+        )
+        assert cyclomatic_complexity(body) == 1
+
+
+class TestGoParserCognitive:
+    """Tests for cognitive complexity."""
+
+    def test_straight_line_is_zero(self) -> None:
+        """A body without control flow scores 0."""
+        assert cognitive_complexity("\n    return a + b\n") == 0
+
+    def test_deep_nesting_penalty(self) -> None:
+        """Each nested flow break adds its nesting depth."""
+        body = (
+            "\n    for _, x := range xs {\n"  # +1
+            "        if x > 0 {\n"  # +2
+            "            for i := 0; i < x; i++ {\n"  # +3
+            "                if i%2 == 0 && x > 1 {\n"  # +4, && +1
+            "                    total++\n"
+            "                }\n"
+            "            }\n"
+            "        }\n"
+            "    }\n"
+        )
+        assert cognitive_complexity(body) == 11
+
+    def test_func_literal_nests_switch(self) -> None:
+        """Function literals nest; a switch scores once, else branches flat."""
+        body = (
+            "\n    f := func(x int) int {\n"
+            "        switch x {\n"  # +2
+            "        case 1:\n"
+            "            return 1\n"
+            "        case 2:\n"
+            "            return 2\n"
+            "        }\n"
+            "        if x > 0 {\n"  # +2
+            "            return 3\n"
+            "        } else {\n"  # +1
+            "            return 4\n"
+            "        }\n"
+            "    }\n"
+            "    return f(1)\n"
+        )
+        assert cognitive_complexity(body) == 5
+
+    def test_boolean_sequences(self) -> None:
+        """A run of one operator counts once; switching operators adds one."""
+        assert cognitive_complexity("\n    ok := a && b && c\n") == 1
+        assert cognitive_complexity("\n    ok := a && b || c\n") == 2
+        assert cognitive_complexity("\n    x := a && b\n    y := c && d\n") == 2
+        assert cognitive_complexity("\n    ok := a &&\n        b && c\n") == 1
+
+    def test_labelled_jumps(self) -> None:
+        """``goto`` and labelled ``break``/``continue`` add one each."""
+        body = (
+            "\nouter:\n"
+            "    for {\n"  # +1
+            "        select {\n"  # +2
+            "        case <-done:\n"
+            "            break outer\n"  # +1
+            "        }\n"
+            "        goto end\n"  # +1
+            "    }\n"
+            "end:\n"
+        )
+        assert cognitive_complexity(body) == 5
+
+    def test_parser_populates_cognitive(self) -> None:
+        """GoParser stores cognitive complexity on each function."""
+        src = (
+            "package main\n\n"
+            "func F(x int) int {\n"
+            "    if x > 0 {\n"
+            "        if x > 10 {\n"
+            "            return 2\n"
+            "        }\n"
+            "    }\n"
+            "    return 0\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert report.cognitive_map == {"F": 3}
+
+    def test_header_composite_literal(self) -> None:
+        """A composite literal in a loop header does not take the block's nesting."""
+        body = '\n    for _, v := range []string{"a"} {\n        if v == "" {\n        }\n    }\n'
+        # for +1, nested if +2
+        assert cognitive_complexity(body) == 3
+
+
+class TestGoParserNesting:
+    """Tests for control-flow nesting depth."""
+
+    def test_triple_nesting(self) -> None:
+        """``for { if { switch { } } }`` is three levels deep."""
+        body = """
+    for _, v := range items {
+        if v > 0 {
+            switch v {
+            case 1:
+                go func() { total++ }()
+            }
+        }
+    }
+"""
+        assert nesting_depth(body) == 3
+
+    def test_else_chain_stays_level(self) -> None:
+        """``else if`` / ``else`` branches do not deepen nesting."""
+        body = "if a {\n} else if b {\n} else {\n    if c {\n    }\n}\n"
+        assert nesting_depth(body) == 2
+
+    def test_straight_line_code(self) -> None:
+        """Bare blocks and composite literals add no nesting."""
+        assert nesting_depth("x := T{A: 1}\n{\n    y := x\n}\n") == 0
+
+    def test_header_composite_literal(self) -> None:
+        """Only the brace after the header opens the block."""
+        body = 'for _, v := range []string{"a"} { if v == "" {} }'
+        assert nesting_depth(body) == 2
+        body = "if ok := m[k]; ok {\n    for _, p := range map[string]int{} {\n    }\n}\n"
+        assert nesting_depth(body) == 2
+
+    def test_deepest_nesting(self) -> None:
+        """The file reports the most deeply nested function."""
+        src = (
+            "package main\n\n"
+            "func Flat() {}\n\n"
+            "func Walk(xs []int) {\n"
+            "    for _, x := range xs {\n"
+            "        if x > 0 {\n"
+            "            select {\n"
+            "            default:\n"
+            "            }\n"
+            "        }\n"
+            "    }\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert report.deepest_nesting == ("Walk", 3)
+        assert _parse_source("package main\n\nfunc F() {}\n").deepest_nesting is None
+
+
+class TestGoParserSignatureCounts:
+    """Tests for parameter and return-value counts."""
+
+    def test_result_forms(self) -> None:
+        """Bare, parenthesised and named result lists are counted."""
+        assert result_count("") == 0
+        assert result_count(" error ") == 1
+        assert result_count("(int, error)") == 2
+        assert result_count("(x, y int, err error)") == 3
+        assert result_count("(m map[string]int, f func(a, b int) bool)") == 2
+
+    def test_exceeding_limits(self) -> None:
+        """A six-parameter function exceeds a limit of five."""
+        src = (
+            "package main\n\n"
+            "func Wide(a, b, c int, d string, e []byte, f bool) (int, string, error) {\n"
+            "    return 0, \"\", nil\n"
+            "}\n\n"
+            "type S struct{}\n\n"
+            "func (s *S) Pair() (int, int) { return 1, 2 }\n"
+        )
+        report = _parse_source(src)
+        wide = report.functions[0]
+        assert (wide.num_parameters, wide.return_count) == (6, 3)
+        assert report.functions_exceeding_params(5) == ["Wide"]
+        assert report.functions_exceeding_params(6) == []
+        assert report.functions_exceeding_returns(1) == ["Wide", "S.Pair"]
+
+
+class TestGoParserReceivers:
+    """Tests for pointer/value receiver tracking."""
+
+    def test_receiver_decorators(self) -> None:
+        """Methods are tagged with their receiver kind."""
+        report = _parse_source(_MIXED_RECEIVERS)
+        methods = {m.name: m.decorators for m in report.classes[0].methods}
+        assert methods == {
+            "Put": ("pointer_receiver",),
+            "Get": ("value_receiver",),
+            "Len": ("value_receiver",),
+        }
+
+    def test_mixed_receivers_flagged(self) -> None:
+        """A type with both pointer and value receivers is reported."""
+        report = _parse_source(_MIXED_RECEIVERS)
+        profile = report.receiver_profiles["Store"]
+        assert profile.pointer_methods == ("Put",)
+        assert profile.value_methods == ("Get", "Len")
+        assert report.mixed_receiver_types == ["Store"]
+
+    def test_sample_fixture_not_mixed(self) -> None:
+        """The fixture Calculator uses pointer receivers only."""
+        report = _parse_fixture()
+        profile = report.receiver_profiles["Calculator"]
+        assert profile.pointer_methods == ("Add", "Reset")
+        assert profile.value_methods == ()
+        assert report.mixed_receiver_types == []
+
+
+class TestGoParserPanics:
+    """Tests for panic/recover call sites."""
+
+    def test_panic_site_recorded(self) -> None:
+        """A deliberate panic is recorded with its position and function."""
+        src = (
+            "package main\n"
+            "\n"
+            "func Must(err error) {\n"
+            "    if err != nil {\n"
+            '        panic("oops")\n'
+            "    }\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert report.total_panics == 1
+        site = report.panic_sites[0]
+        assert (site.name, site.line, site.column, site.function) == ("panic", 5, 9, "Must")
+        assert report.total_recovers == 0
+
+    def test_recover_in_method_and_noise_ignored(self) -> None:
+        """Recover inside a deferred literal is attributed to the method."""
+        src = (
+            "package main\n"
+            "\n"
+            "func (s *Server) Serve() {\n"
+            "    defer func() { _ = recover() }()\n"
+            '    // panic("not real")\n'
+            '    log.Panic("qualified")\n'
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert report.panic_sites == ()
+        assert [(s.line, s.function) for s in report.recover_sites] == [(4, "Server.Serve")]
+
+
+class TestGoParserConcurrency:
+    """Tests for goroutine and channel-operation sites."""
+
+    def test_goroutine_send_and_close(self) -> None:
+        """A go statement, a send and a close are each recorded."""
+        src = (
+            "package main\n"
+            "\n"
+            "func Run() {\n"
+            "    ch := make(chan int, 1)\n"
+            "    go func() {}()\n"
+            "    ch <- 1\n"
+            "    close(ch)\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert [(s.name, s.line, s.function) for s in report.goroutine_sites] == [
+            ("func", 5, "Run")
+        ]
+        assert [(op.kind, op.line, op.function) for op in report.channel_ops] == [
+            (ChannelOpKind.MAKE, 4, "Run"),
+            (ChannelOpKind.SEND, 6, "Run"),
+            (ChannelOpKind.CLOSE, 7, "Run"),
+        ]
+
+    def test_receive_forms_and_channel_types(self) -> None:
+        """Receives are told apart from sends; directional types are skipped."""
+        src = (
+            "package main\n"
+            "\n"
+            "func (w *Worker) Loop(in <-chan int, out chan<- int) {\n"
+            "    go w.drain(in)\n"
+            "    select {\n"
+            "    case v := <-in:\n"
+            "        out <- v\n"
+            "    case <-w.done:\n"
+            "        return\n"
+            "    }\n"
+            "    // ch <- 1 in a comment\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert [s.name for s in report.goroutine_sites] == ["w.drain"]
+        kinds = [(op.kind, op.line) for op in report.channel_ops]
+        assert kinds == [
+            (ChannelOpKind.RECEIVE, 6),
+            (ChannelOpKind.SEND, 7),
+            (ChannelOpKind.RECEIVE, 8),
+        ]
+        assert {op.function for op in report.channel_ops} == {"Worker.Loop"}
+
+
+_TYPE_CHECKS = """\
+package shapes
+
+func Describe(v interface{}) string {
+    s := v.(fmt.Stringer)
+    if n, ok := v.(int); ok {
+        return strconv.Itoa(n)
+    }
+    switch x := v.(type) {
+    case int, int64:
+        return "int"
+    case string:
+        switch x.(type) {
+        case bool:
+        }
+        return x
+    case *Shape:
+        return "shape"
+    default:
+    }
+    return s.String()
+}
+"""
+
+
+class TestGoParserTypeAssertions:
+    """Tests for type assertion and type switch sites."""
+
+    def test_assertion_forms(self) -> None:
+        """Plain and comma-ok assertions are told apart; switches excluded."""
+        report = _parse_source(_TYPE_CHECKS)
+        assert [(a.type_name, a.comma_ok, a.line) for a in report.type_assertions] == [
+            ("fmt.Stringer", False, 4),
+            ("int", True, 5),
+        ]
+        assert {a.function for a in report.type_assertions} == {"Describe"}
+        assert report.total_type_assertions == 2
+
+    def test_type_switch_cases(self) -> None:
+        """Only the switch's own case clauses are counted."""
+        report = _parse_source(_TYPE_CHECKS)
+        assert [(s.cases, s.line, s.function) for s in report.type_switches] == [
+            (3, 8, "Describe"),
+            (1, 12, "Describe"),
+        ]
+        assert report.average_type_switch_cases == 2.0
+
+    def test_interface_in_signature(self) -> None:
+        """``interface{}`` in the result type does not hide the body."""
+        src = "package main\n\nfunc Any() interface{} {\n    return box.(T)\n}\n"
+        report = _parse_source(src)
+        assert report.type_assertions[0].function == "Any"
+        assert report.functions[0].lines == 3
+
+    def test_no_type_switches(self) -> None:
+        """The average is zero without type switches."""
+        assert _parse_source("package main\n").average_type_switch_cases == 0.0
+
+
+_SWITCHES = """\
+package main
+
+func Classify(n int, v any, ch chan int) string {
+    switch {
+    case n < 0:
+        return "neg"
+    default:
+        return "pos"
+    }
+    switch n {
+    case 1, 2:
+        select {
+        case <-ch:
+        }
+    case 3:
+    }
+    switch v.(type) {
+    case int:
+        switch x := n % 2; x {
+        case 0:
+        default:
+        }
+    }
+    select {
+    case <-ch:
+    }
+    return ""
+}
+"""
+
+
+class TestGoParserMissingDefault:
+    """Tests for switches without a ``default`` clause."""
+
+    def test_only_switches_without_default(self) -> None:
+        """Switches with default, nested defaults and selects are not reported."""
+        report = _parse_source(_SWITCHES)
+        sites = [
+            (s.cases, s.line, s.function, s.is_type_switch)
+            for s in report.missing_default_switches
+        ]
+        assert sites == [
+            (2, 10, "Classify", False),
+            (1, 17, "Classify", True),
+        ]
+
+    def test_switch_with_default_only(self) -> None:
+        """A switch that handles the default case is never reported."""
+        src = "package main\n\nfunc F(n int) {\n    switch n {\n    default:\n    }\n}\n"
+        assert _parse_source(src).missing_default_switches == ()
+
+    def test_composite_literal_in_header(self) -> None:
+        """Clauses are read from the switch block, not from a header literal."""
+        src = (
+            "package main\n\nfunc F() {\n"
+            "    switch n := len([]int{1, 2}); n {\n    case 1:\n    default:\n    }\n"
+            "    switch v := []string{\"a\"}[0]; v {\n    case \"a\":\n    }\n}\n"
+        )
+        sites = [(s.cases, s.line) for s in _parse_source(src).missing_default_switches]
+        assert sites == [(1, 8)]
+
+
+_GLOBALS = """\
+package store
+
+import "sync"
+
+const Limit = 10
+
+var cache map[string]int
+
+var once sync.Once
+
+var (
+    mu      sync.RWMutex
+    hits, misses int
+    lookup  = map[string]int{
+        "a": 1,
+    }
+    guard   = &sync.Mutex{}
+    _       fmt.Stringer = (*Item)(nil)
+)
+
+func Get(key string) int {
+    var local int
+    return cache[key] + local
+}
+"""
+
+
+class TestGoParserGlobalVars:
+    """Tests for package-level mutable variable detection."""
+
+    def test_plain_global_only(self) -> None:
+        """A plain global is reported; sync.Once and constants are not."""
+        src = "package main\n\nconst Max = 3\n\nvar once sync.Once\n\nvar count int\n"
+        report = _parse_source(src)
+        assert [(g.name, g.type_name, g.line) for g in report.global_vars] == [
+            ("count", "int", 7),
+        ]
+        assert report.mutable_global_count == 1
+
+    def test_var_block(self) -> None:
+        """Block specs are split by name; sync types and ``_`` are skipped."""
+        report = _parse_source(_GLOBALS)
+        assert [(g.name, g.type_name, g.line) for g in report.global_vars] == [
+            ("cache", "map[string]int", 7),
+            ("hits", "int", 13),
+            ("misses", "int", 13),
+            ("lookup", "", 14),
+        ]
+
+    def test_function_locals_ignored(self) -> None:
+        """``var`` inside a function body is not global."""
+        report = _parse_source(_GLOBALS)
+        assert "local" not in {g.name for g in report.global_vars}
+
+    def test_function_literal_locals_ignored(self) -> None:
+        """``var`` inside a package-level function literal is not global."""
+        src = "package main\n\nvar handler = func() {\n    var local int\n    _ = local\n}\n"
+        report = _parse_source(src)
+        assert [g.name for g in report.global_vars] == ["handler"]
+
+    def test_block_then_function_literal_locals_ignored(self) -> None:
+        """A ``var ( ... )`` block does not reset depth for later declarations."""
+        src = (
+            "package main\n\n"
+            "var (\n    a int\n)\n\n"
+            "var handler = func() {\n    var inner int\n    _ = inner\n}\n"
+        )
+        report = _parse_source(src)
+        assert [g.name for g in report.global_vars] == ["a", "handler"]
+
+
+_RECURSION = """\
+package walk
+
+func f() { f() }
+
+func isEven(n int) bool {
+    if n == 0 {
+        return true
+    }
+    return isOdd(n - 1)
+}
+
+func isOdd(n int) bool {
+    if n == 0 {
+        return false
+    }
+    return isEven(n - 1)
+}
+
+type Tree struct{ kids []*Tree }
+
+func (t *Tree) Size() int {
+    n := 1
+    for _, k := range t.kids {
+        n += k.Size()
+    }
+    return n + t.Depth()
+}
+
+func (t *Tree) Depth() int { return len(t.kids) }
+
+func main() {
+    // f() in a comment is not a call
+    fmt.Println(isEven(4))
+}
+"""
+
+
+class TestGoParserRecursion:
+    """Tests for the call graph and recursion detection."""
+
+    def test_call_graph_edges(self) -> None:
+        """Plain calls and receiver method calls resolve within the file."""
+        graph = _parse_source(_RECURSION).call_graph
+        assert graph is not None
+        assert graph.callees("main") == ["isEven"]
+        assert graph.callees("Tree.Size") == ["Tree.Depth"]
+        assert graph.callees("Tree.Depth") == []
+        assert ("Tree.Depth", "len") in graph.unresolved
+
+    def test_direct_and_mutual_recursion(self) -> None:
+        """``f`` calls itself; ``isEven`` and ``isOdd`` call each other."""
+        report = _parse_source(_RECURSION)
+        assert report.direct_recursion == ["f"]
+        assert report.mutual_recursion == [["isEven", "isOdd"]]
+
+    def test_fixture_not_recursive(self) -> None:
+        """The sample fixture has no recursion of either kind."""
+        report = _parse_fixture()
+        assert report.direct_recursion == []
+        assert report.mutual_recursion == []
+
+
+class TestGoParserBodyHash:
+    """Tests for structural body normalisation and hashing."""
+
+    def test_identifiers_erased(self) -> None:
+        """Names become ``_``; keywords, operators and literals stay."""
+        assert normalize_body(' if total > 10 { return "big" } // note\n') == (
+            'if _ > 10 { return "big" }'
+        )
+
+    def test_renamed_copy_shares_hash(self) -> None:
+        """Bodies that differ only in naming hash equally; literals matter."""
+        src = (
+            "package main\n\n"
+            "func Sum(xs []int) int {\n\ttotal := 0\n\tfor _, x := range xs {\n"
+            "\t\ttotal += x\n\t}\n\treturn total\n}\n\n"
+            "func Add(vals []int) int {\n\tacc := 0\n\tfor _, v := range vals {\n"
+            "\t\tacc += v\n\t}\n\treturn acc\n}\n\n"
+            "func Off(vals []int) int {\n\tacc := 1\n\tfor _, v := range vals {\n"
+            "\t\tacc += v\n\t}\n\treturn acc\n}\n"
+        )
+        hashes = {f.name: f.body_hash for f in _parse_source(src).functions}
+        assert hashes["Sum"] is not None
+        assert hashes["Sum"] == hashes["Add"]
+        assert hashes["Off"] != hashes["Sum"]
+
+    def test_comment_marker_in_literal_kept(self) -> None:
+        """Text after ``//`` inside a string still distinguishes bodies."""
+        a = '\n    url := "http://a" + path\n    return get(url)\n'
+        b = '\n    url := "http://a"\n    return url\n'
+        assert normalize_body(a) == '_ := "http://a" + _ return _ ( _ )'
+        assert body_hash(a) != body_hash(b)
+
+
+_ERRORS = """\
+package conv
+
+import (
+    "fmt"
+    "strconv"
+)
+
+func Ignore(s string) int {
+    n, _ := strconv.Atoi(s)
+    return n
+}
+
+func Check(s string) (int, error) {
+    n, err := strconv.Atoi(s)
+    if err != nil {
+        return 0, err
+    }
+    return n, nil
+}
+
+func Wrap(s string) (int, error) {
+    n, err := strconv.Atoi(s)
+    if err != nil {
+        return 0, fmt.Errorf("parse %q: %w", s, err)
+    }
+    return n, nil
+}
+"""
+
+
+class TestGoParserErrorHandling:
+    """Tests for error return and handling classification."""
+
+    def test_ignore_check_wrap(self) -> None:
+        """Each function of the fixture gets the expected three flags."""
+        flags = {f.name: f.error_handling for f in _parse_source(_ERRORS).functions}
+        ignore, check, wrap = flags["Ignore"], flags["Check"], flags["Wrap"]
+        assert ignore is not None
+        assert check is not None
+        assert wrap is not None
+        assert (ignore.returns_error, ignore.all_errors_checked) == (False, False)
+        assert (check.returns_error, check.all_errors_checked) == (True, True)
+        assert not check.uses_error_wrapping
+        assert (wrap.returns_error, wrap.all_errors_checked, wrap.uses_error_wrapping) == (
+            True,
+            True,
+            True,
+        )
+        assert _parse_source(_ERRORS).unchecked_errors == ["Ignore"]
+
+    def test_overwritten_error_is_unchecked(self) -> None:
+        """An error reassigned before being read was never checked."""
+        body = "\n\terr := a()\n\terr = b()\n\treturn err\n"
+        assert not error_handling("error", body).all_errors_checked
+
+    def test_non_call_assignments_ignored(self) -> None:
+        """``range`` loops, comparisons and literal assignments are not error sites."""
+        body = (
+            "\n\tfor _, x := range xs {\n\t\tok := x == y\n\t\t_ = ok\n\t}\n"
+            "\tif err := run(); err != nil {\n\t\tlog.Print(err)\n\t}\n"
+        )
+        report = error_handling("", body)
+        assert report.all_errors_checked
+        assert not report.returns_error
+
+    def test_wrapping_needs_percent_w(self) -> None:
+        """``fmt.Errorf`` with only ``%v`` does not count as wrapping."""
+        body = '\n\treturn fmt.Errorf("failed: %v", err)\n'
+        assert not error_handling("error", body).uses_error_wrapping
+
+    def test_wrapping_with_url_in_format(self) -> None:
+        """A ``//`` inside the format string does not hide ``%w``."""
+        body = '\n\treturn fmt.Errorf("get http://host: %w", err)\n'
+        assert error_handling("error", body).uses_error_wrapping
+
+
+class TestGoParserSymbolUsages:
+    """Tests for symbol usage search."""
+
+    def test_fixture_calculator_usages(self) -> None:
+        """Calculator is used in the Computable interface and Add's signature."""
+        source = _FIXTURE.read_text()
+        sites = symbol_usages(source, "Calculator")
+
+        assert {s.kind for s in sites} == {UsageKind.TYPE_REF}
+        assert [(s.line, s.column) for s in sites] == [(19, 14), (30, 10), (30, 34), (43, 10)]
+
+    def test_usage_kinds(self) -> None:
+        """Calls, composite literals, field accesses and package-level uses."""
+        src = (
+            "package main\n\n"
+            "var fallback = Point{}\n\n"
+            "type Point struct {\n    X int\n}\n\n"
+            "func Point3() {}\n\n"
+            "func run(p *Point) int {\n"
+            "    q := Point{X: 1}\n"
+            "    Point3()\n"
+            "    return p.X + q.X\n"
+            "}\n"
+        )
+        kinds = [(s.line, s.kind, s.function) for s in symbol_usages(src, "Point")]
+        assert kinds == [
+            (3, UsageKind.COMPOSITE_LITERAL, ""),
+            (11, UsageKind.TYPE_REF, ""),
+            (12, UsageKind.COMPOSITE_LITERAL, "run"),
+        ]
+        fields = [(s.line, s.kind) for s in symbol_usages(src, "X")]
+        assert fields == [
+            (12, UsageKind.FIELD_ACCESS),
+            (14, UsageKind.FIELD_ACCESS),
+            (14, UsageKind.FIELD_ACCESS),
+        ]
+        assert [s.kind for s in symbol_usages(src, "Point3")] == [UsageKind.CALL]
+
+    def test_comments_and_strings_ignored(self) -> None:
+        """Mentions in comments and string literals are not usages."""
+        src = 'package main\n\n// Point is here\nvar s = "Point"\n'
+        assert symbol_usages(src, "Point") == ()
+
+
+class TestGoParserFunctionLength:
+    """Tests for function line spans and the length histogram."""
+
+    def test_sample_fixture_lengths(self) -> None:
+        """Spans run from the ``func`` line to the closing brace inclusive."""
+        report = _parse_fixture()
+        spans = {name: (f.line, f.end_line, f.lines) for name, f in report.qualified_functions}
+        assert spans == {
+            "Helper": (49, 52, 4),
+            "Calculator.Add": (30, 40, 11),
+            "Calculator.Reset": (43, 46, 4),
+        }
+        assert report.length_histogram["1-10"] == 2
+        assert report.length_histogram["11-25"] == 1
+        assert report.longest_function == ("Calculator.Add", 11)
+
+    def test_long_function_bucket(self) -> None:
+        """A 62-line function lands in the 51-100 bucket."""
+        body = "".join(f"    x{i} := {i}\n" for i in range(60))
+        report = _parse_source(f"package main\n\nfunc Long() {{\n{body}}}\n")
+        assert report.functions[0].lines == 62
+        assert report.length_histogram["51-100"] == 1
+
+    def test_multiline_signature(self) -> None:
+        """The span starts at ``func`` even when the brace is further down."""
+        src = "package main\n\nfunc Wide(\n    a int,\n) int {\n    return a\n}\n"
+        func = _parse_source(src).functions[0]
+        assert (func.line, func.end_line, func.lines) == (3, 7, 5)
+
+    def test_brace_in_comment(self) -> None:
+        """A ``}`` in a comment does not end the body early."""
+        src = (
+            "package main\n\n"
+            "func f() {\n"
+            "\t// close with }\n"
+            "\tx := 1\n"
+            "\tif x > 0 {\n"
+            "\t\tx++\n"
+            "\t}\n"
+            "}\n"
+        )
+        func = _parse_source(src).functions[0]
+        assert (func.line, func.end_line) == (3, 9)
+        assert func.cyclomatic_complexity == 2
+
+    def test_brace_in_rune(self) -> None:
+        """A ``'{'`` rune does not run the body into the next function."""
+        src = (
+            "package main\n\n"
+            "func f() rune { return '{' }\n\n"
+            "func g(x int) int {\n"
+            "\tif x > 0 {\n"
+            "\t\treturn 1\n"
+            "\t}\n"
+            "\treturn 0\n"
+            "}\n"
+        )
+        f, g = _parse_source(src).functions
+        assert (f.line, f.end_line, f.cyclomatic_complexity) == (3, 3, 1)
+        assert (g.line, g.end_line, g.cyclomatic_complexity) == (5, 10, 2)
+
+    def test_brace_in_string(self) -> None:
+        """A ``"switch x {"`` string does not open a block."""
+        src = (
+            "package main\n\n"
+            "func f() string {\n"
+            '\treturn "switch x {"\n'
+            "}\n\n"
+            "func g() {}\n"
+        )
+        f, g = _parse_source(src).functions
+        assert (f.line, f.end_line) == (3, 5)
+        assert (g.line, g.end_line) == (7, 7)
+
+    def test_brace_in_raw_string(self) -> None:
+        """Braces in a raw string literal are not counted."""
+        src = (
+            "package main\n\n"
+            "func tmpl() string {\n"
+            "\treturn `{{ range .Items }}\n"
+            "}}`\n"
+            "}\n\n"
+            "func g() {}\n"
+        )
+        tmpl, g = _parse_source(src).functions
+        assert (tmpl.line, tmpl.end_line) == (3, 6)
+        assert (g.line, g.end_line) == (8, 8)
+
+
+class TestGoParserDocumentation:
+    """Tests for doc comments and documentation coverage."""
+
+    def test_sample_fixture_fully_documented(self) -> None:
+        """Every exported symbol in the fixture carries a doc comment."""
+        report = _parse_fixture()
+        docs = report.documentation
+        assert docs.exported_symbols == 5
+        assert docs.documented_symbols == 5
+        assert docs.coverage_ratio == 1.0
+        assert report.undocumented_exports == []
+
+    def test_docstring_is_first_comment_line(self) -> None:
+        """The docstring is the first line of the comment block."""
+        src = (
+            "package main\n\n"
+            "// Run starts the loop.\n"
+            "//\n"
+            "// It blocks until ctx is done.\n"
+            "func Run() {}\n"
+        )
+        assert _parse_source(src).functions[0].docstring == "Run starts the loop."
+
+    def test_undocumented_export_reported(self) -> None:
+        """An exported function without a doc comment is flagged."""
+        src = (
+            "package main\n\n"
+            "// Documented is fine.\n"
+            "func Documented() {}\n\n"
+            "func Naked() {}\n\n"
+            "func unexported() {}\n"
+        )
+        report = _parse_source(src)
+        assert report.undocumented_exports == ["Naked"]
+        assert report.documentation.exported_symbols == 2
+        assert report.documentation.coverage_ratio == 0.5
+
+    def test_blank_line_detaches_comment(self) -> None:
+        """A comment separated by a blank line is not a doc comment."""
+        src = "package main\n\n// Stray note.\n\ntype Box struct {\n    N int\n}\n"
+        report = _parse_source(src)
+        assert report.classes[0].docstring is None
+        assert report.undocumented_exports == ["Box"]
+
+    def test_methods_on_unexported_types_ignored(self) -> None:
+        """Exported methods only count when their type is exported too."""
+        src = (
+            "package main\n\n"
+            "type impl struct {}\n\n"
+            "func (i *impl) Do() {}\n\n"
+            "// Svc is a service.\n"
+            "type Svc struct {}\n\n"
+            "func (s *Svc) Serve() {}\n"
+        )
+        assert _parse_source(src).undocumented_exports == ["Svc.Serve"]
+
+    def test_package_level_values_not_counted(self) -> None:
+        """Exported ``var`` and ``const`` are outside the coverage scope."""
+        src = (
+            "package main\n\n"
+            "var Registry = map[string]int{}\n\n"
+            "const Version = \"1.0\"\n\n"
+            "func Naked() {}\n"
+        )
+        report = _parse_source(src)
+        assert report.undocumented_exports == ["Naked"]
+        assert report.documentation.exported_symbols == 1
+
+
+_EMBEDDING_CHAIN = """\
+package shapes
+
+type Base struct {
+    ID int
+}
+
+type Named struct {
+    Base
+    Name string `json:"name"`
+}
+
+type Labelled struct {
+    *Named
+    Label, Colour string
+}
+
+type Widget struct {
+    Labelled
+    io.Reader
+    Size int // bytes
+    Meta struct {
+        Owner string
+    }
+}
+"""
+
+
+class TestGoParserStructFields:
+    """Tests for struct field counts and embedding depth."""
+
+    def test_fields_and_embedded_types(self) -> None:
+        """Named fields become attributes, embedded types base classes."""
+        report = _parse_source(_EMBEDDING_CHAIN)
+        classes = {c.name: c for c in report.classes}
+        assert classes["Labelled"].attributes == ("Label", "Colour")
+        assert classes["Labelled"].base_classes == ("Named",)
+        assert classes["Widget"].attributes == ("Size", "Meta")
+        assert classes["Widget"].base_classes == ("Labelled", "io.Reader")
+
+    def test_three_level_embedding_depth(self) -> None:
+        """Widget -> Labelled -> Named -> Base is three levels deep."""
+        report = _parse_source(_EMBEDDING_CHAIN)
+        stats = report.struct_stats
+        assert stats["Base"].embedding_depth == 0
+        assert stats["Named"].embedding_depth == 1
+        assert stats["Labelled"].embedding_depth == 2
+        assert stats["Widget"].embedding_depth == 3
+        assert stats["Widget"].field_count == 4
+        assert stats["Widget"].embedded_count == 2
+        assert report.deepest_embedding == ("Widget", 3)
+
+    def test_external_embedding_counts_one_level(self) -> None:
+        """A type from another file or package is one level deep."""
+        src = "package main\n\ntype Conn struct { net.Conn; closed bool }\n"
+        report = _parse_source(src)
+        assert report.struct_stats["Conn"].embedding_depth == 1
+        assert report.struct_stats["Conn"].field_count == 2
+
+    def test_embedding_cycle_terminates(self) -> None:
+        """Mutually embedding pointer types do not recurse forever."""
+        src = "package main\n\ntype A struct { *B }\n\ntype B struct { *A }\n"
+        assert _parse_source(src).struct_stats["A"].embedding_depth == 2
+
+    def test_no_embedding(self) -> None:
+        """Without embedded fields there is no deepest embedding."""
+        src = "package main\n\ntype P struct {\n    X, Y int\n}\n"
+        report = _parse_source(src)
+        assert report.struct_stats["P"].field_count == 2
+        assert report.deepest_embedding is None
+
+
+_SHAPES = """\
+package shapes
+
+type Shape interface {
+    Area() float64
+    Scale(factor float64)
+}
+
+type Solid interface {
+    Shape
+    Volume() float64
+}
+
+type Streamer interface {
+    io.Reader
+}
+
+type Square struct{ side float64 }
+
+func (s Square) Area() float64 { return s.side * s.side }
+
+func (s *Square) Scale(factor float64) { s.side *= factor }
+
+type Cube struct {
+    Square
+}
+
+func (c Cube) Volume() float64 { return c.side * c.side * c.side }
+
+type Circle struct{ r float64 }
+
+func (c Circle) Area() float64 { return 3.14 * c.r * c.r }
+
+func (c *Circle) Scale() {}
+"""
+
+
+class TestGoParserInterfaces:
+    """Tests for interface method specs and the compliance matrix."""
+
+    def test_interface_methods_recorded(self) -> None:
+        """Method specs are kept on the interface, not counted as methods."""
+        classes = {c.name: c for c in _parse_source(_SHAPES).classes}
+        shape = classes["Shape"]
+        assert [(m.name, m.num_parameters, m.line) for m in shape.interface_methods] == [
+            ("Area", 0, 4),
+            ("Scale", 1, 5),
+        ]
+        assert shape.methods == ()
+        assert classes["Solid"].base_classes == ("Shape",)
+        assert classes["Streamer"].base_classes == ("io.Reader",)
+
+    def test_compliance_matrix(self) -> None:
+        """Types are matched by method name and parameter count."""
+        matrix = _parse_source(_SHAPES).interface_compliance
+        # Circle.Scale takes no arguments, so Circle is not a Shape.
+        assert matrix["Shape"] == ["Cube", "Square"]
+        assert matrix["Solid"] == ["Cube"]
+        # io.Reader's methods are unknown here.
+        assert matrix["Streamer"] == []
+
+    def test_fixture_calculator_is_computable(self) -> None:
+        """In the sample fixture Calculator implements Computable."""
+        report = _parse_fixture()
+        assert interface_matrix(report) == {"Computable": ["Calculator"]}
+
+
+_WIDE = """\
+package store
+
+type Store interface {
+    Get(key string) (string, error)
+    Put(key, value string) error
+    Delete(key string) error
+    Has(key string) bool
+    Keys() []string
+    Len() int
+    Clear()
+    Close() error
+    Flush(ctx context.Context, force bool) (n int, err error)
+    Stats() map[string]int
+}
+
+type Getter interface {
+    Get(key string) (string, error)
+}
+"""
+
+
+class TestGoParserInterfaceStats:
+    """Tests for interface sizes and the fat-interface check."""
+
+    def test_fixture_computable_has_two_methods(self) -> None:
+        """The sample fixture's Computable declares Add and Reset."""
+        (computable,) = _parse_fixture().interfaces
+        assert computable.name == "Computable"
+        assert computable.method_count == 2
+        assert [(m.name, m.param_count, m.return_count) for m in computable.methods] == [
+            ("Add", 1, 1),
+            ("Reset", 0, 0),
+        ]
+
+    def test_signature_counts(self) -> None:
+        """Grouped parameters and named results count once each."""
+        store = _parse_source(_WIDE).interfaces[0]
+        sigs = {m.name: (m.param_count, m.return_count) for m in store.methods}
+        assert sigs["Get"] == (1, 2)
+        assert sigs["Put"] == (2, 1)
+        assert sigs["Clear"] == (0, 0)
+        assert sigs["Flush"] == (2, 2)
+
+    def test_fat_interfaces_threshold(self) -> None:
+        """A 10-method interface is flagged at threshold 5 but not at 10."""
+        report = _parse_source(_WIDE)
+        assert [(i.name, i.method_count) for i in report.interfaces] == [
+            ("Store", 10),
+            ("Getter", 1),
+        ]
+        assert report.fat_interfaces(5) == ["Store"]
+        assert report.fat_interfaces(10) == []
+
+    def test_structs_excluded(self) -> None:
+        """Structs are not reported as interfaces."""
+        names = [i.name for i in _parse_source(_SHAPES).interfaces]
+        assert names == ["Shape", "Solid", "Streamer"]
+
+
+class TestGoParserHalstead:
+    """Tests for Halstead counts."""
+
+    def test_operators_and_operands(self) -> None:
+        """Operators and operands are classified and counted."""
+        counts = halstead("\n    total := a + b*2\n    return total\n")
+        # operators: := + *      operands: total a b 2 total
+        assert counts.distinct_operators == 3
+        assert counts.total_operators == 3
+        assert counts.distinct_operands == 4
+        assert counts.total_operands == 5
+
+    def test_comments_ignored_strings_are_operands(self) -> None:
+        """Comments are skipped; literals containing operators are one operand."""
+        counts = halstead('\n    // x = y + z\n    msg := "a + b"\n')
+        assert counts.distinct_operators == 1
+        assert counts.total_operands == 2
+
+    def test_comment_marker_inside_literals(self) -> None:
+        """``//`` and ``/*`` inside string, raw-string and rune literals do not start comments."""
+        counts = halstead('\n    x := "http://a" + y\n')
+        # operators: := +      operands: x "http://a" y
+        assert counts.total_operators == 2
+        assert counts.total_operands == 3
+
+        raw = halstead("\n    p := `/*` + q // trailing\n    r := '/'\n")
+        assert raw.total_operators == 3
+        assert raw.total_operands == 5
+
+    def test_keywords_are_not_operands(self) -> None:
+        """Go keywords do not count as operands."""
+        counts = halstead("\n    for i := range xs {\n        return\n    }\n")
+        assert counts.total_operands == 2
+
+    def test_fixture_add_baseline(self) -> None:
+        """``Calculator.Add`` matches the hand-computed baseline."""
+        counts = _parse_fixture().halstead_map["Calculator.Add"]
+        # operators: > += < += =          -> n1=4, N1=5
+        # operands:  x(5) 0(2) c(5) Value(2) History(2) append(1) -> n2=6, N2=17
+        expected = {
+            "distinct_operators": 4,
+            "distinct_operands": 6,
+            "total_operators": 5,
+            "total_operands": 17,
+            "vocabulary": 10,
+            "length": 22,
+            "volume": 73.082,
+            "difficulty": 5.667,
+            "effort": 414.134,
+        }
+        for metric, value in expected.items():
+            assert round(getattr(counts, metric), 3) == value, metric
+
+
+class TestGoParserMaintainability:
+    """Tests for the per-function maintainability index."""
+
+    def test_sample_fixture_add(self) -> None:
+        """MI of ``Add`` matches the formula applied by hand."""
+        report = _parse_fixture()
+        add = dict(report.qualified_functions)["Calculator.Add"]
+        # V = 22 * log2(10), CC = 3, LOC = 11
+        volume = 22 * math.log2(10)
+        expected = (171 - 5.2 * math.log(volume) - 0.23 * 3 - 16.2 * math.log(11)) * 100 / 171
+        assert add.maintainability_index is not None
+        assert abs(add.maintainability_index - expected) < 0.01
+        assert abs(add.maintainability_index - 63.83) < 0.01
+        average = report.average_maintainability_index
+        assert average is not None
+        assert add.maintainability_index < average < 100
+
 
 class TestGoParserFixture:
     """Tests against the hand-verified sample fixture."""
 
     def test_sample_fixture(self) -> None:
         """Parse the sample fixture and verify expected values."""
-        fixtures = Path(__file__).resolve().parents[3] / "fixtures"
-        sample = fixtures / "sample_files" / "go" / "sample.go"
-        if not sample.exists():
+        if not _FIXTURE.exists():
             return
         parser = GoParser()
-        report = parser.parse(sample, sample.parent.parent.parent.parent)
+        report = parser.parse(_FIXTURE, _FIXTURE.parent.parent.parent.parent)
         class_names = {c.name for c in report.classes}
         assert "Calculator" in class_names
         assert "Computable" in class_names
         assert report.num_functions >= 1
         assert "fmt" in report.imports
+
+    def test_sample_fixture_loc(self) -> None:
+        """LOC counts match the fixture's expected-values header."""
+        report = _parse_fixture()
+        assert report.total_lines == 52
+        assert report.blank_lines == 7
+        assert report.comment_lines == 17
+        assert report.code_lines == 28
+
+    def test_block_comment_lines(self) -> None:
+        """Go ``/* */`` blocks count as comment lines."""
+        src = "package main\n\n/*\nPackage docs.\n*/\nfunc F() {} // trailing\n"
+        report = _parse_source(src)
+        assert report.comment_lines == 3
+        assert report.code_lines == 2
+
+    def test_sample_fixture_complexity(self) -> None:
+        """Every function and method in the fixture gets a computed CC."""
+        report = _parse_fixture()
+        assert report.complexity_map == {
+            "Helper": 1,
+            "Calculator.Add": 3,
+            "Calculator.Reset": 1,
+        }
+        nesting = {name: f.nesting_depth for name, f in report.qualified_functions}
+        assert nesting == {"Helper": 0, "Calculator.Add": 1, "Calculator.Reset": 0}
+        helper = report.functions[0]
+        assert (helper.num_parameters, helper.return_count) == (3, 1)
+        assert report.cognitive_map == {
+            "Helper": 0,
+            "Calculator.Add": 3,
+            "Calculator.Reset": 0,
+        }
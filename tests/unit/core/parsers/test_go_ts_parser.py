@@ -7,6 +7,7 @@ from typing import TYPE_CHECKING
 
 import pytest
 
+from dev_stats.core.parsers.go_parser import body_hash
 from dev_stats.core.parsers.tree_sitter_base import _tree_sitter_available
 
 if TYPE_CHECKING:
@@ -21,6 +22,9 @@ pytestmark = pytest.mark.skipif(
 def _parse_source(source: str, filename: str = "main.go") -> FileReport:
     """Parse a source string with GoTreeSitterParser.
 
+    Every call also checks that :meth:`GoTreeSitterParser.parse_bytes`
+    produces the same report as parsing the file from disk.
+
     Args:
         source: Go source code.
         filename: Filename to use.
@@ -35,7 +39,9 @@ def _parse_source(source: str, filename: str = "main.go") -> FileReport:
     test_file = tmp / filename
     test_file.write_text(source)
     parser = GoTreeSitterParser()
-    return parser.parse(test_file, tmp)
+    report = parser.parse(test_file, tmp)
+    assert parser.parse_bytes(source.encode(), Path(filename)) == report
+    return report
 
 
 class TestGoTSStructs:
@@ -175,6 +181,227 @@ func standalone() {}
         assert report.functions[0].name == "standalone"
 
 
+class TestGoTSPackage:
+    """Tests for package clause detection."""
+
+    def test_package_detected(self) -> None:
+        """The package clause is recorded on the report."""
+        report = _parse_source("package calc_test\n")
+        assert report.package == "calc_test"
+
+
+class TestGoTSPanics:
+    """Tests for panic/recover call sites."""
+
+    def test_panic_site_recorded(self) -> None:
+        """A panic call is recorded with its enclosing function."""
+        report = _parse_source('package main\n\nfunc F() {\n    panic("oops")\n}\n')
+        assert [(s.line, s.function) for s in report.panic_sites] == [(4, "F")]
+
+
+class TestGoTSConcurrency:
+    """Tests for goroutine and channel-operation sites."""
+
+    def test_goroutine_and_close(self) -> None:
+        """The tree-sitter parser reports the same sites as the regex one."""
+        src = "package main\n\nfunc F(ch chan int) {\n    go g()\n    close(ch)\n}\n"
+        report = _parse_source(src)
+        assert [(s.name, s.line) for s in report.goroutine_sites] == [("g", 4)]
+        assert [op.kind.value for op in report.channel_ops] == ["close"]
+
+
+class TestGoTSTypeAssertions:
+    """Tests for type assertion and type switch sites."""
+
+    def test_sites_reported(self) -> None:
+        """The tree-sitter parser reports the same sites as the regex one."""
+        src = (
+            "package main\n\n"
+            "func F(v any) {\n"
+            "    _, ok := v.(error)\n"
+            "    switch v.(type) {\n"
+            "    case int:\n"
+            "    case string:\n"
+            "    }\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert [(a.type_name, a.comma_ok) for a in report.type_assertions] == [("error", True)]
+        assert [(s.cases, s.function) for s in report.type_switches] == [(2, "F")]
+
+
+class TestGoTSReceivers:
+    """Tests for pointer/value receiver tracking."""
+
+    def test_mixed_receivers_flagged(self) -> None:
+        """Receiver kinds are tagged and mixed types reported."""
+        src = (
+            "package store\n\n"
+            "type Store struct{}\n\n"
+            "func (s *Store) Put() {}\n\n"
+            "func (s Store) Get() int { return 0 }\n"
+        )
+        report = _parse_source(src)
+        profile = report.receiver_profiles["Store"]
+        assert profile.pointer_methods == ("Put",)
+        assert profile.value_methods == ("Get",)
+        assert report.mixed_receiver_types == ["Store"]
+
+
+class TestGoTSDocumentation:
+    """Tests for doc comments."""
+
+    def test_undocumented_export_reported(self) -> None:
+        """Doc comments are attached and the undocumented export is flagged."""
+        src = (
+            "package main\n\n"
+            "// Box holds a value.\n"
+            "type Box struct{}\n\n"
+            "// Open opens the box.\n"
+            "func (b *Box) Open() {}\n\n"
+            "func Naked() {}\n"
+        )
+        report = _parse_source(src)
+        assert report.classes[0].docstring == "Box holds a value."
+        assert report.classes[0].methods[0].docstring == "Open opens the box."
+        assert report.undocumented_exports == ["Naked"]
+
+
+class TestGoTSNesting:
+    """Tests for control-flow nesting depth."""
+
+    def test_triple_nesting(self) -> None:
+        """``for { if { switch { } } }`` scores 3; ``else if`` stays level."""
+        src = (
+            "package main\n\n"
+            "func F(xs []int) {\n"
+            "    for _, x := range xs {\n"
+            "        if x > 0 {\n"
+            "            switch x {\n"
+            "            case 1:\n"
+            "            }\n"
+            "        } else if x < 0 {\n"
+            "        }\n"
+            "    }\n"
+            "}\n"
+        )
+        report = _parse_source(src)
+        assert report.functions[0].nesting_depth == 3
+        assert report.deepest_nesting == ("F", 3)
+
+
+class TestGoTSSignatureCounts:
+    """Tests for parameter and return-value counts."""
+
+    def test_counts(self) -> None:
+        """Named and unnamed results are counted like the regex parser."""
+        src = (
+            "package main\n\n"
+            "func A(a, b, c int) int { return a }\n\n"
+            "func B() (n int, err error) { return }\n\n"
+            "func C() {}\n"
+        )
+        report = _parse_source(src)
+        counts = [(f.num_parameters, f.return_count) for f in report.functions]
+        assert counts == [(3, 1), (0, 2), (0, 0)]
+
+
+class TestGoTSGlobalVars:
+    """Tests for package-level mutable variables."""
+
+    def test_plain_global_only(self) -> None:
+        """Only the plain global is reported."""
+        src = "package main\n\nconst Max = 3\n\nvar once sync.Once\n\nvar count int\n"
+        report = _parse_source(src)
+        assert [g.name for g in report.global_vars] == ["count"]
+        assert report.mutable_global_count == 1
+
+
+class TestGoTSRecursion:
+    """Tests for recursion detection."""
+
+    def test_direct_and_mutual(self) -> None:
+        """The call graph is shared with the regex parser."""
+        src = (
+            "package main\n\n"
+            "func f() { f() }\n\n"
+            "func a() { b() }\n\n"
+            "func b() { a() }\n"
+        )
+        report = _parse_source(src)
+        assert report.direct_recursion == ["f"]
+        assert report.mutual_recursion == [["a", "b"]]
+
+
+class TestGoTSBodyHash:
+    """Tests for body hashing."""
+
+    def test_matches_regex_parser(self) -> None:
+        """Both Go parsers hash the same body identically."""
+        src = "package main\n\nfunc Double(n int) int {\n\treturn n * 2\n}\n"
+        report = _parse_source(src)
+        assert report.functions[0].body_hash == body_hash("\n\treturn n * 2\n")
+
+
+class TestGoTSErrorHandling:
+    """Tests for error handling classification."""
+
+    def test_checked_and_wrapped(self) -> None:
+        """The shared heuristic sees the body and result of each function."""
+        src = (
+            "package main\n\nimport \"fmt\"\n\n"
+            "func Load(p string) error {\n\tif err := read(p); err != nil {\n"
+            '\t\treturn fmt.Errorf("load: %w", err)\n\t}\n\treturn nil\n}\n'
+        )
+        flags = _parse_source(src).functions[0].error_handling
+        assert flags is not None
+        assert (flags.returns_error, flags.all_errors_checked, flags.uses_error_wrapping) == (
+            True,
+            True,
+            True,
+        )
+
+
+class TestGoTSStructFields:
+    """Tests for struct fields and embedding."""
+
+    def test_embedding_chain(self) -> None:
+        """Embedded types are resolved across structs in the file."""
+        src = (
+            "package main\n\n"
+            "type Base struct{ ID int }\n\n"
+            "type Mid struct {\n    *Base\n    Name string\n}\n\n"
+            "type Top struct {\n    Mid\n    io.Reader\n}\n"
+        )
+        report = _parse_source(src)
+        top = next(c for c in report.classes if c.name == "Top")
+        assert top.base_classes == ("Mid", "io.Reader")
+        assert report.deepest_embedding == ("Top", 2)
+
+
+class TestGoTSInterfaces:
+    """Tests for interface method specs and compliance."""
+
+    def test_compliance_matrix(self) -> None:
+        """Types covering every spec by name and arity implement the interface."""
+        src = (
+            "package main\n\n"
+            "type Namer interface {\n    Name() string\n    Rename(to string)\n}\n\n"
+            "type User struct{ name string }\n\n"
+            "func (u User) Name() string { return u.name }\n\n"
+            "func (u *User) Rename(to string) { u.name = to }\n\n"
+            "type Tag struct{}\n\n"
+            "func (t Tag) Name() string { return \"tag\" }\n"
+        )
+        report = _parse_source(src)
+        namer = next(c for c in report.classes if c.name == "Namer")
+        assert [(m.name, m.num_parameters) for m in namer.interface_methods] == [
+            ("Name", 0),
+            ("Rename", 1),
+        ]
+        assert report.interface_compliance == {"Namer": ["User"]}
+
+
 class TestGoTSImports:
     """Tests for import detection."""
 
@@ -76,6 +76,16 @@ class TestJSParserMethods:
         add = next(m for m in report.classes[0].methods if m.name == "add")
         assert len(add.parameters) == 2
 
+    def test_control_statements_not_methods(self) -> None:
+        """``if``/``for``/``while`` blocks inside a method are not methods."""
+        src = (
+            "class Foo {\n    run(xs) {\n        for (const x of xs) {\n"
+            "            if (x) {\n                while (x) {\n                }\n"
+            "            }\n        }\n    }\n}\n"
+        )
+        report = _parse_source(src)
+        assert [m.name for m in report.classes[0].methods] == ["run"]
+
 
 class TestJSParserFunctions:
     """Tests for top-level function extraction."""
@@ -114,3 +114,18 @@ class TestTSParserFixture:
         assert "Computable" in class_names
         assert "Operation" in class_names
         assert "path" in report.imports
+
+    def test_sample_fixture_exact_counts(self) -> None:
+        """The fixture's documented counts hold exactly."""
+        fixtures = Path(__file__).resolve().parents[3] / "fixtures"
+        sample = fixtures / "sample_files" / "typescript" / "sample.ts"
+        report = TypeScriptParser().parse(sample, fixtures)
+        kinds = {c.name: c.decorators for c in report.classes}
+        calculator = next(c for c in report.classes if c.name == "Calculator")
+
+        assert "interface" in kinds["Computable"]
+        assert [f.name for f in report.functions] == ["helper"]
+        assert [m.name for m in calculator.methods] == ["constructor", "add", "reset"]
+        assert report.imports == ("path",)
+        assert report.complexity_map["Calculator.add"] == 3
+        assert report.complexity_map["helper"] == 1
@@ -15,6 +15,7 @@ def _make_file(
     code: int = 80,
     blank: int = 10,
     comment: int = 10,
+    package: str | None = None,
 ) -> FileReport:
     """Create a FileReport for testing.
 
@@ -25,6 +26,7 @@ def _make_file(
         code: Code lines.
         blank: Blank lines.
         comment: Comment lines.
+        package: Declared package name.
 
     Returns:
         A ``FileReport``.
@@ -36,6 +38,7 @@ def _make_file(
         code_lines=code,
         blank_lines=blank,
         comment_lines=comment,
+        package=package,
     )
 
 
@@ -111,6 +114,79 @@ class TestAggregatorModuleReports:
         assert report.modules[0].name == "(root)"
 
 
+class TestAggregatorPackages:
+    """Tests for per-package bucketing within a directory."""
+
+    def test_single_package_directory(self) -> None:
+        """A single-package directory keeps one module with its plain name."""
+        files = [
+            _make_file("calc/a.go", language="go", package="calc"),
+            _make_file("calc/b.go", language="go", package="calc"),
+        ]
+        report = Aggregator().aggregate(files, Path("/repo"))
+        assert [(m.name, m.package, len(m.files)) for m in report.modules] == [
+            ("calc", "calc", 2)
+        ]
+
+    def test_mixed_packages_are_bucketed(self) -> None:
+        """``foo`` and ``foo_test`` in one directory become two modules."""
+        files = [
+            _make_file("foo/foo.go", language="go", total=40, package="foo"),
+            _make_file("foo/foo_test.go", language="go", total=25, package="foo_test"),
+        ]
+        report = Aggregator().aggregate(files, Path("/repo"))
+        by_pkg = {m.package: m for m in report.modules}
+        assert set(by_pkg) == {"foo", "foo_test"}
+        assert by_pkg["foo"].name == "foo [foo]"
+        assert by_pkg["foo"].total_lines == 40
+        assert by_pkg["foo_test"].total_lines == 25
+
+    def test_parsed_sources_are_bucketed(self, tmp_path: Path) -> None:
+        """Package clauses read by GoParser drive the bucketing."""
+        from dev_stats.core.parsers.go_parser import GoParser
+
+        (tmp_path / "foo.go").write_text("package foo\n\nfunc A() {}\n")
+        (tmp_path / "foo_test.go").write_text("package foo_test\n\nfunc TestA() {}\n")
+        parser = GoParser()
+        files = [parser.parse(tmp_path / n, tmp_path) for n in ("foo.go", "foo_test.go")]
+
+        report = Aggregator().aggregate(files, tmp_path)
+        assert sorted(m.package or "" for m in report.modules) == ["foo", "foo_test"]
+        assert all(m.num_functions == 1 for m in report.modules)
+
+    def test_merge_modules(self) -> None:
+        """merge_modules lumps every file back together."""
+        files = [
+            _make_file("foo/foo.go", language="go", total=40, package="foo"),
+            _make_file("foo/foo_test.go", language="go", total=25, package="foo_test"),
+        ]
+        report = Aggregator().aggregate(files, Path("/repo"))
+        merged = Aggregator.merge_modules(report.modules)
+        assert merged.name == "(all)"
+        assert merged.path == Path("foo")
+        assert merged.package is None
+        assert len(merged.files) == 2
+        assert merged.total_lines == 65
+
+    def test_merge_modules_empty(self) -> None:
+        """Merging nothing yields an empty root module."""
+        merged = Aggregator.merge_modules(())
+        assert merged.files == ()
+        assert merged.path == Path(".")
+
+    def test_merge_modules_common_parent(self) -> None:
+        """The merged path is the deepest directory shared by every module."""
+        files = [
+            _make_file("pkg/a/a.go", language="go", total=10, package="a"),
+            _make_file("pkg/b/b.go", language="go", total=10, package="b"),
+            _make_file("pkg/bc/c.go", language="go", total=10, package="c"),
+        ]
+        report = Aggregator().aggregate(files, Path("/repo"))
+        assert Aggregator.merge_modules(report.modules).path == Path("pkg")
+        cmd = Aggregator().aggregate([_make_file("cmd/x.go", language="go")], Path("/repo"))
+        assert Aggregator.merge_modules(report.modules + cmd.modules).path == Path(".")
+
+
 class TestAggregatorWithStructure:
     """Tests with classes and methods."""
 
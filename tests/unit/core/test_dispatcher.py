@@ -0,0 +1,88 @@
+"""Unit tests for Dispatcher parallel parsing."""
+
+from __future__ import annotations
+
+import shutil
+import time
+from pathlib import Path
+from typing import TYPE_CHECKING
+from unittest.mock import MagicMock
+
+import pytest
+
+from dev_stats.core.dispatcher import Dispatcher, _parse_in_worker
+from dev_stats.core.parser_registry import create_default_registry
+
+if TYPE_CHECKING:
+    from collections.abc import Callable
+
+_SAMPLE = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files" / "go" / "sample.go"
+
+
+def _copy_fixture(tmp_path: Path, count: int) -> list[Path]:
+    """Copy the Go sample fixture *count* times into *tmp_path*.
+
+    Args:
+        tmp_path: Destination directory.
+        count: Number of copies.
+
+    Returns:
+        Repository-relative paths of the copies.
+    """
+    paths: list[Path] = []
+    for i in range(count):
+        rel = Path(f"pkg{i % 10}") / f"sample_{i:03d}.go"
+        (tmp_path / rel.parent).mkdir(exist_ok=True)
+        shutil.copy(_SAMPLE, tmp_path / rel)
+        paths.append(rel)
+    return paths
+
+
+class TestDispatcherParallel:
+    """Tests for ``parse_many(jobs=...)``."""
+
+    def test_parallel_matches_serial(self, tmp_path: Path) -> None:
+        """Parallel parsing returns the same reports, sorted by path."""
+        paths = _copy_fixture(tmp_path, 12)
+        dispatcher = Dispatcher(registry=create_default_registry(), repo_root=tmp_path)
+        serial = dispatcher.parse_many(paths)
+        parallel = dispatcher.parse_many(list(reversed(paths)), jobs=3)
+        assert parallel == serial
+        assert [r.path for r in parallel] == sorted(paths)
+
+    def test_worker_returns_errors(self) -> None:
+        """A failing parse becomes an error string the parent can log."""
+        failing = MagicMock()
+        failing.parse.side_effect = OSError("permission denied")
+        assert _parse_in_worker(failing, Path("bad.go")) == (None, "OSError: permission denied")
+
+    def test_progress_called_per_file(self, tmp_path: Path) -> None:
+        """on_progress fires once per input path."""
+        paths = _copy_fixture(tmp_path, 5)
+        dispatcher = Dispatcher(registry=create_default_registry(), repo_root=tmp_path)
+        calls: list[int] = []
+        dispatcher.parse_many(paths, jobs=2, on_progress=lambda: calls.append(1))
+        assert len(calls) == 5
+
+    @pytest.mark.slow
+    def test_benchmark_serial_vs_parallel(
+        self, tmp_path: Path, record_property: Callable[[str, object], None]
+    ) -> None:
+        """Parse 100 fixture copies serially and with one worker per CPU.
+
+        Timings are recorded as test properties (``--junitxml`` output).
+        """
+        paths = _copy_fixture(tmp_path, 100)
+        dispatcher = Dispatcher(registry=create_default_registry(), repo_root=tmp_path)
+
+        start = time.perf_counter()
+        serial = dispatcher.parse_many(paths)
+        serial_s = time.perf_counter() - start
+
+        start = time.perf_counter()
+        parallel = dispatcher.parse_many(paths, jobs=0)
+        parallel_s = time.perf_counter() - start
+
+        assert parallel == serial
+        record_property("serial_s", round(serial_s, 3))
+        record_property("parallel_s", round(parallel_s, 3))
@@ -0,0 +1,146 @@
+"""Unit tests for FunctionQuery and FunctionStats."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.function_query import FunctionQuery
+from dev_stats.core.models import ClassReport, FileReport, MethodReport, ParameterReport
+
+
+def _make_file() -> FileReport:
+    """Build a Go file with two functions and two methods of known metrics."""
+    return FileReport(
+        path=Path("pkg/calc.go"),
+        language="go",
+        total_lines=60,
+        code_lines=50,
+        blank_lines=10,
+        comment_lines=0,
+        package="calc",
+        functions=(
+            MethodReport(
+                name="New",
+                line=1,
+                end_line=5,
+                lines=5,
+                parameters=(ParameterReport(name="seed"),),
+                return_count=2,
+                cyclomatic_complexity=2,
+            ),
+            MethodReport(name="helper", line=7, end_line=30, lines=24, cyclomatic_complexity=6),
+        ),
+        classes=(
+            ClassReport(
+                name="Calculator",
+                line=32,
+                end_line=60,
+                lines=29,
+                methods=(
+                    MethodReport(
+                        name="Add",
+                        line=34,
+                        end_line=45,
+                        lines=12,
+                        cyclomatic_complexity=6,
+                        cognitive_complexity=4,
+                    ),
+                    MethodReport(name="Reset", line=47, end_line=49, lines=3),
+                ),
+            ),
+        ),
+    )
+
+
+class TestFunctionStats:
+    """Tests for the flat per-function records."""
+
+    def test_fields_flattened(self) -> None:
+        """Each record carries its file, package, receiver and metrics."""
+        stats = {fs.qualified_name: fs for fs in _make_file().function_stats}
+        new = stats["calc.New"]
+        assert new.receiver is None
+        assert new.file == Path("pkg/calc.go")
+        assert (new.line, new.end_line, new.lines) == (1, 5, 5)
+        assert (new.param_count, new.return_count) == (1, 2)
+
+        add = stats["calc.Calculator.Add"]
+        assert add.receiver == "Calculator"
+        assert (add.cyclomatic_complexity, add.cognitive_complexity) == (6, 4)
+
+    def test_order_and_name_without_package(self) -> None:
+        """Records follow qualified_functions order; no package means no prefix."""
+        f = _make_file()
+        assert [fs.qualified_name for fs in f.function_stats] == [
+            f"calc.{n}" for n, _ in f.qualified_functions
+        ]
+
+        py = FileReport(
+            path=Path("mod.py"),
+            language="python",
+            total_lines=2,
+            code_lines=2,
+            blank_lines=0,
+            comment_lines=0,
+            functions=(MethodReport(name="main", line=1, end_line=2, lines=2),),
+        )
+        assert py.function_stats[0].qualified_name == "main"
+
+
+class TestSorting:
+    """Tests for the complexity and length orderings."""
+
+    def test_by_complexity_descending_keeps_ties_in_source_order(self) -> None:
+        """Highest CC first; helper and Add tie and stay in source order."""
+        names = [f.name for f in FunctionQuery([_make_file()]).by_complexity()]
+        assert names == ["helper", "Add", "New", "Reset"]
+
+    def test_by_complexity_ascending(self) -> None:
+        """ascending=True puts the simplest function first."""
+        names = [f.name for f in FunctionQuery([_make_file()]).by_complexity(ascending=True)]
+        assert names == ["Reset", "New", "helper", "Add"]
+
+    def test_by_lines(self) -> None:
+        """Longest first by default, shortest first when ascending."""
+        query = FunctionQuery([_make_file()])
+        assert [f.lines for f in query.by_lines()] == [24, 12, 5, 3]
+        assert [f.lines for f in query.by_lines(ascending=True)] == [3, 5, 12, 24]
+
+    def test_spans_several_files(self) -> None:
+        """Functions from every file are ranked together."""
+        other = FileReport(
+            path=Path("pkg/big.go"),
+            language="go",
+            total_lines=100,
+            code_lines=100,
+            blank_lines=0,
+            comment_lines=0,
+            package="calc",
+            functions=(MethodReport(name="Big", line=1, end_line=100, lines=100),),
+        )
+        query = FunctionQuery([_make_file(), other])
+        assert len(query.functions) == 5
+        assert query.by_lines()[0].file == Path("pkg/big.go")
+
+
+class TestMatching:
+    """Tests for glob matching on qualified names."""
+
+    def test_receiver_glob(self) -> None:
+        """A receiver glob selects that type's methods."""
+        names = [f.name for f in FunctionQuery([_make_file()]).matching("calc.Calculator.*")]
+        assert names == ["Add", "Reset"]
+
+    def test_wildcards_and_case(self) -> None:
+        """``?`` and character classes work, and matching is case-sensitive."""
+        query = FunctionQuery([_make_file()])
+        assert [f.name for f in query.matching("*.[A-Z]??")] == ["New", "Add"]
+        assert query.matching("calc.new") == []
+        assert [f.name for f in query.matching("*helper")] == ["helper"]
+
+    def test_empty_pattern_rejected(self) -> None:
+        """An empty pattern raises ValueError."""
+        with pytest.raises(ValueError, match="empty"):
+            FunctionQuery([_make_file()]).matching("")
@@ -0,0 +1,83 @@
+"""Tests for GoModReader."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.go_mod_reader import GoModReader
+from dev_stats.core.models import GoModDependency
+
+_GO_MOD = """\
+// Service module.
+module github.com/acme/service
+
+go 1.22
+
+toolchain go1.22.3
+
+require github.com/spf13/cobra v1.8.0
+
+require (
+\tgithub.com/google/uuid v1.6.0
+\tgolang.org/x/mod v0.17.0
+\tgithub.com/inconshreveable/mousetrap v1.1.0 // indirect
+\tgithub.com/spf13/pflag v1.0.5 // indirect; needed by cobra
+)
+
+replace (
+\tgithub.com/google/uuid => ../uuid
+)
+
+exclude golang.org/x/mod v0.16.0
+"""
+
+
+class TestGoModReader:
+    """Tests for parsing go.mod files."""
+
+    def test_module_and_go_version(self) -> None:
+        """The module path and go directive are read."""
+        report = GoModReader().parse(_GO_MOD)
+        assert report.module_path == "github.com/acme/service"
+        assert report.go_version == "1.22"
+
+    def test_dependency_counts(self) -> None:
+        """Single-line and block requires are split by the indirect marker."""
+        report = GoModReader().parse(_GO_MOD)
+        assert report.num_direct_dependencies == 3
+        assert report.num_indirect_dependencies == 2
+        assert [d.path for d in report.direct_dependencies] == [
+            "github.com/spf13/cobra",
+            "github.com/google/uuid",
+            "golang.org/x/mod",
+        ]
+        assert report.indirect_dependencies[0] == GoModDependency(
+            path="github.com/inconshreveable/mousetrap", version="v1.1.0", indirect=True
+        )
+
+    def test_replace_and_exclude_ignored(self) -> None:
+        """Entries in other directives are not dependencies."""
+        report = GoModReader().parse(_GO_MOD)
+        assert len(report.dependencies) == 5
+        assert all("=>" not in d.path for d in report.dependencies)
+
+    def test_quoted_module_path(self) -> None:
+        """A quoted module path is unquoted."""
+        assert GoModReader().parse('module "example.com/m"\n').module_path == "example.com/m"
+
+    def test_missing_module_directive(self) -> None:
+        """A file without a module directive is rejected."""
+        with pytest.raises(ValueError, match="no module directive"):
+            GoModReader().parse("go 1.21\n")
+
+    def test_read_from_directory(self, tmp_path: Path) -> None:
+        """read() loads go.mod from the given directory."""
+        (tmp_path / "go.mod").write_text(_GO_MOD, encoding="utf-8")
+        assert GoModReader().read(tmp_path).num_direct_dependencies == 3
+
+    def test_read_missing_file(self, tmp_path: Path) -> None:
+        """read() raises FileNotFoundError without a go.mod."""
+        with pytest.raises(FileNotFoundError):
+            GoModReader().read(tmp_path)
@@ -3,6 +3,7 @@
 from __future__ import annotations
 
 import dataclasses
+import math
 from datetime import UTC, datetime
 from pathlib import Path
 
@@ -11,6 +12,7 @@ from dev_stats.core.models import (
     BlameLine,
     BranchReport,
     BranchStatus,
+    CallGraph,
     ChangeType,
     ClassReport,
     CommitRecord,
@@ -18,18 +20,25 @@ from dev_stats.core.models import (
     ContributorProfile,
     DeletabilityCategory,
     DetectedPattern,
+    Distribution,
+    DocumentationStats,
     EnrichedCommit,
     FileBlameReport,
     FileChange,
+    FileExtras,
     FileReport,
+    HalsteadReport,
     LanguageSummary,
     MergeStatus,
     MergeType,
     MethodReport,
     ModuleReport,
     ParameterReport,
+    ReceiverProfile,
     RepoReport,
     TagRecord,
+    distribution,
+    maintainability_index,
 )
 
 _NOW = datetime(2024, 6, 15, 12, 0, 0, tzinfo=UTC)
@@ -110,6 +119,82 @@ class TestMethodReport:
         assert m.num_parameters == 2
 
 
+class TestHalsteadReport:
+    """Tests for HalsteadReport."""
+
+    def test_derived_metrics(self) -> None:
+        """Vocabulary, length, volume, difficulty and effort are derived."""
+        hr = HalsteadReport(
+            distinct_operators=4, distinct_operands=4, total_operators=8, total_operands=8
+        )
+        assert hr.vocabulary == 8
+        assert hr.length == 16
+        assert hr.volume == 48.0
+        assert hr.difficulty == 4.0
+        assert hr.effort == 192.0
+
+    def test_empty_is_zero(self) -> None:
+        """An empty function has zero volume and difficulty."""
+        hr = HalsteadReport()
+        assert hr.volume == 0.0
+        assert hr.difficulty == 0.0
+        assert hr.effort == 0.0
+
+
+class TestMaintainabilityIndex:
+    """Tests for maintainability_index and MethodReport.maintainability_index."""
+
+    def test_formula(self) -> None:
+        """The normalised formula is applied and clamped at zero."""
+        expected = (171 - 5.2 * math.log(100) - 0.23 * 5 - 16.2 * math.log(20)) * 100 / 171
+        assert abs(maintainability_index(5, 100.0, 20) - expected) < 1e-9
+        assert maintainability_index(500, 1e9, 100_000) == 0.0
+
+    def test_degenerate_inputs(self) -> None:
+        """Zero volume and zero LOC do not raise."""
+        assert abs(maintainability_index(0, 0.0, 0) - 100.0) < 1e-9
+
+    def test_method_without_halstead(self) -> None:
+        """Functions without Halstead counts have no index."""
+        assert MethodReport(name="f", line=1, end_line=2, lines=2).maintainability_index is None
+
+
+class TestReceiverProfile:
+    """Tests for ReceiverProfile."""
+
+    def test_is_mixed(self) -> None:
+        """Only a type with both receiver kinds is mixed."""
+        assert ReceiverProfile("T", ("A",), ("B",)).is_mixed
+        assert not ReceiverProfile("T", ("A", "B")).is_mixed
+        assert not ReceiverProfile("T", value_methods=("B",)).is_mixed
+
+
+class TestDocumentationStats:
+    """Tests for DocumentationStats."""
+
+    def test_coverage_ratio(self) -> None:
+        """Coverage is documented / exported, 1.0 when nothing is exported."""
+        assert DocumentationStats(4, 3).coverage_ratio == 0.75
+        assert DocumentationStats().coverage_ratio == 1.0
+
+
+class TestCallGraph:
+    """Tests for CallGraph strongly connected components."""
+
+    def test_components(self) -> None:
+        """Tarjan groups cycles; acyclic nodes stand alone."""
+        graph = CallGraph(
+            nodes=("a", "b", "c", "d", "e"),
+            edges=(("a", "b"), ("b", "c"), ("c", "a"), ("c", "d"), ("e", "e")),
+        )
+        assert graph.strongly_connected_components() == [["a", "b", "c"], ["d"], ["e"]]
+        assert graph.callees("c") == ["a", "d"]
+
+    def test_empty(self) -> None:
+        """An empty graph has no components."""
+        assert CallGraph().strongly_connected_components() == []
+
+
 class TestClassReport:
     """Tests for ClassReport."""
 
@@ -138,6 +223,34 @@ class TestClassReport:
 class TestFileReport:
     """Tests for FileReport."""
 
+    def test_undocumented_exports_python(self) -> None:
+        """Outside Go, names without a leading underscore are exported."""
+        fr = FileReport(
+            path=Path("m.py"),
+            language="python",
+            total_lines=10,
+            code_lines=10,
+            blank_lines=0,
+            comment_lines=0,
+            size_bytes=100,
+            classes=(
+                ClassReport(
+                    name="Api",
+                    line=1,
+                    end_line=5,
+                    lines=5,
+                    docstring="Public API.",
+                    methods=(
+                        MethodReport(name="call", line=2, end_line=3, lines=2),
+                        MethodReport(name="_helper", line=4, end_line=5, lines=2),
+                    ),
+                ),
+            ),
+            functions=(MethodReport(name="run", line=6, end_line=7, lines=2, docstring="Go."),),
+        )
+        assert fr.undocumented_exports == ["Api.call"]
+        assert fr.documentation == DocumentationStats(exported_symbols=3, documented_symbols=2)
+
     def test_comment_ratio(self) -> None:
         """comment_ratio returns correct float."""
         fr = FileReport(
@@ -191,6 +304,122 @@ class TestFileReport:
         )
         _assert_frozen(fr, "language", "java")
 
+    def test_length_histogram_boundaries(self) -> None:
+        """Bucket upper bounds are inclusive; every bucket is reported."""
+        funcs = tuple(
+            MethodReport(name=f"f{n}", line=1, end_line=n, lines=n) for n in (10, 11, 100, 101)
+        )
+        fr = FileReport(
+            path=Path("a.go"),
+            language="go",
+            total_lines=300,
+            code_lines=300,
+            blank_lines=0,
+            comment_lines=0,
+            functions=funcs,
+        )
+        assert fr.length_histogram == {
+            "1-10": 1,
+            "11-25": 1,
+            "26-50": 0,
+            "51-100": 1,
+            "101+": 1,
+        }
+        assert fr.longest_function == ("f101", 101)
+        assert fr.average_function_length == 55.5
+
+    def test_length_stats_empty(self) -> None:
+        """A file without functions has no longest function and zero average."""
+        fr = FileReport(
+            path=Path("a.go"),
+            language="go",
+            total_lines=0,
+            code_lines=0,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        assert fr.longest_function is None
+        assert fr.average_function_length == 0.0
+
+    def test_package_key(self) -> None:
+        """The key combines the directory and the package name."""
+        keys = [
+            FileReport(
+                path=Path(path),
+                language="go",
+                total_lines=0,
+                code_lines=0,
+                blank_lines=0,
+                comment_lines=0,
+                package=package,
+            ).package_key
+            for path, package in (("cmd/a/main.go", "main"), ("main.go", "main"), ("x/y.go", None))
+        ]
+        assert keys == ["cmd/a [main]", "(root) [main]", "x [-]"]
+
+    def test_extras_are_file_report_fields(self) -> None:
+        """Every ``FileExtras`` key names a ``FileReport`` field."""
+        fields = {f.name for f in dataclasses.fields(FileReport)}
+        assert FileExtras.__optional_keys__ <= fields
+        assert "call_graph" in FileExtras.__optional_keys__
+
+
+class TestDistribution:
+    """Tests for per-function metric distributions."""
+
+    def test_known_cc_values(self) -> None:
+        """CC values 1..5 give exact mean, median, percentiles and spread."""
+        funcs = tuple(
+            MethodReport(
+                name=f"f{cc}", line=1, end_line=cc * 2, lines=cc * 2, cyclomatic_complexity=cc
+            )
+            for cc in (4, 1, 5, 2, 3)
+        )
+        fr = FileReport(
+            path=Path("a.go"),
+            language="go",
+            total_lines=40,
+            code_lines=40,
+            blank_lines=0,
+            comment_lines=0,
+            functions=funcs,
+        )
+        cc = fr.cc_distribution
+        assert cc.mean == 3.0
+        assert cc.median == 3.0
+        assert cc.p90 == 5.0
+        assert cc.p99 == 5.0
+        assert cc.std_dev == math.sqrt(2)
+        assert (cc.min, cc.max) == (1, 5)
+        assert fr.loc_distribution.median == 6.0
+
+    def test_even_count_median(self) -> None:
+        """An even count averages the two middle values."""
+        dist = distribution([1, 2, 3, 10])
+        assert dist.median == 2.5
+        assert dist.mean == 4.0
+        assert dist.p90 == 10.0
+
+    def test_percentile_indexing(self) -> None:
+        """Percentiles index the sorted values at ``int(p * n)``."""
+        dist = distribution(list(range(1, 101)))
+        assert dist.p90 == 91.0
+        assert dist.p99 == 100.0
+
+    def test_empty_is_zero(self) -> None:
+        """A file without functions yields the zero distribution."""
+        fr = FileReport(
+            path=Path("a.go"),
+            language="go",
+            total_lines=0,
+            code_lines=0,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        assert fr.cc_distribution == Distribution()
+        assert fr.loc_distribution == Distribution()
+        assert distribution([]).std_dev == 0.0
+
 
 class TestModuleReport:
     """Tests for ModuleReport."""
@@ -200,6 +429,31 @@ class TestModuleReport:
         mr = ModuleReport(name="core", path=Path("src/core"))
         assert mr.name == "core"
         assert mr.files == ()
+        assert mr.package is None
+
+    def test_rolled_up_totals(self) -> None:
+        """Line and structure totals sum over the module's files."""
+        func = MethodReport(name="f", line=1, end_line=2, lines=2)
+        files = tuple(
+            FileReport(
+                path=Path(f"core/{n}.go"),
+                language="go",
+                total_lines=10,
+                code_lines=7,
+                blank_lines=2,
+                comment_lines=1,
+                functions=(func,),
+                package="core",
+            )
+            for n in ("a", "b")
+        )
+        mr = ModuleReport(name="core", path=Path("core"), files=files, package="core")
+        assert mr.total_lines == 20
+        assert mr.code_lines == 14
+        assert mr.blank_lines == 4
+        assert mr.comment_lines == 2
+        assert mr.num_classes == 0
+        assert mr.num_functions == 2
 
 
 class TestLanguageSummary:
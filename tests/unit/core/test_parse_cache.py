@@ -0,0 +1,95 @@
+"""Unit tests for the parse-result cache."""
+
+from __future__ import annotations
+
+import shutil
+from pathlib import Path
+from unittest.mock import patch
+
+from dev_stats.core.dispatcher import Dispatcher
+from dev_stats.core.models import FileReport
+from dev_stats.core.parse_cache import DiskParseCache, content_key
+from dev_stats.core.parser_registry import create_default_registry
+
+_SAMPLE = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files" / "go" / "sample.go"
+
+
+class TestContentKey:
+    """Tests for cache key derivation."""
+
+    def test_key_depends_on_bytes_and_parser(self) -> None:
+        """Different content or a different parser yields a different key."""
+        base = content_key(b"package main\n", "GoParser")
+        assert base == content_key(b"package main\n", "GoParser")
+        assert base != content_key(b"package main \n", "GoParser")
+        assert base != content_key(b"package main\n", "GoTreeSitterParser")
+
+
+class TestDiskParseCache:
+    """Tests for DiskParseCache."""
+
+    def test_put_then_get(self, tmp_path: Path) -> None:
+        """A stored report is returned unchanged."""
+        cache = DiskParseCache(tmp_path / "cache")
+        report = FileReport(
+            path=Path("a.go"),
+            language="go",
+            total_lines=1,
+            code_lines=1,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        cache.put("abc", report)
+        assert cache.get("abc") == report
+        assert cache.get("missing") is None
+
+    def test_corrupt_entry_is_miss(self, tmp_path: Path) -> None:
+        """Garbage on disk is treated as a miss."""
+        cache = DiskParseCache(tmp_path)
+        (tmp_path / "bad.pkl").write_bytes(b"not a pickle")
+        assert cache.get("bad") is None
+
+
+class TestDispatcherCache:
+    """Tests for the cache wired into Dispatcher.parse()."""
+
+    def test_second_parse_hits_cache_and_edit_misses(self, tmp_path: Path) -> None:
+        """Unchanged files skip the parser; touching one byte re-parses."""
+        repo = tmp_path / "repo"
+        repo.mkdir()
+        shutil.copy(_SAMPLE, repo / "sample.go")
+        registry = create_default_registry()
+        parser_cls = type(registry.get_or_default(Path("sample.go")))
+        dispatcher = Dispatcher(
+            registry=registry,
+            repo_root=repo,
+            cache=DiskParseCache(tmp_path / "cache"),
+        )
+
+        first = dispatcher.parse(Path("sample.go"))
+        with patch.object(parser_cls, "parse") as parse:
+            second = dispatcher.parse(Path("sample.go"))
+            parse.assert_not_called()
+        assert second == first
+
+        source = (repo / "sample.go").read_bytes()
+        (repo / "sample.go").write_bytes(source + b"\n")
+        with patch.object(parser_cls, "parse", return_value=first) as parse:
+            dispatcher.parse(Path("sample.go"))
+            parse.assert_called_once()
+
+    def test_hit_for_copied_file_uses_new_path(self, tmp_path: Path) -> None:
+        """Identical content at another path reports its own path."""
+        repo = tmp_path / "repo"
+        (repo / "b").mkdir(parents=True)
+        shutil.copy(_SAMPLE, repo / "sample.go")
+        shutil.copy(_SAMPLE, repo / "b" / "copy.go")
+        dispatcher = Dispatcher(
+            registry=create_default_registry(),
+            repo_root=repo,
+            cache=DiskParseCache(tmp_path / "cache"),
+        )
+        first = dispatcher.parse(Path("sample.go"))
+        copy = dispatcher.parse(Path("b/copy.go"))
+        assert copy.path == Path("b/copy.go")
+        assert copy.classes == first.classes
@@ -0,0 +1,128 @@
+"""Unit tests for ReportDiffer."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from dev_stats.core.models import (
+    ClassReport,
+    FileReport,
+    MethodReport,
+    RepoReport,
+    SymbolMetrics,
+)
+from dev_stats.core.report_differ import ReportDiffer
+
+
+def _report(*functions: MethodReport, methods: tuple[MethodReport, ...] = ()) -> RepoReport:
+    """Build a one-file report.
+
+    Args:
+        *functions: Top-level functions.
+        methods: Methods of a ``Calc`` class.
+
+    Returns:
+        A ``RepoReport``.
+    """
+    classes = (ClassReport(name="Calc", line=1, end_line=20, lines=20, methods=methods),)
+    file_rpt = FileReport(
+        path=Path("calc.go"),
+        language="go",
+        total_lines=40,
+        code_lines=30,
+        blank_lines=5,
+        comment_lines=5,
+        classes=classes if methods else (),
+        functions=functions,
+    )
+    return RepoReport(root=Path("/repo"), files=(file_rpt,))
+
+
+def _func(name: str, cc: int = 1, lines: int = 5) -> MethodReport:
+    """Build a function report.
+
+    Args:
+        name: Function name.
+        cc: Cyclomatic complexity.
+        lines: Line count.
+
+    Returns:
+        A ``MethodReport``.
+    """
+    return MethodReport(name=name, line=1, end_line=lines, lines=lines, cyclomatic_complexity=cc)
+
+
+class TestReportDiffer:
+    """Tests for snapshot diffing."""
+
+    def test_cc_increase_is_changed(self) -> None:
+        """A function whose CC grows appears in changed with both values."""
+        before = _report(_func("Helper"), _func("Parse", cc=3))
+        after = _report(_func("Helper"), _func("Parse", cc=5))
+        diff = ReportDiffer().diff(before, after)
+
+        assert diff.added == ()
+        assert diff.removed == ()
+        assert len(diff.changed) == 1
+        delta = diff.changed[0]
+        assert (delta.file, delta.symbol) == ("calc.go", "Parse")
+        assert delta.before == SymbolMetrics(lines=5, cyclomatic_complexity=3)
+        assert delta.after == SymbolMetrics(lines=5, cyclomatic_complexity=5)
+
+    def test_added_and_removed(self) -> None:
+        """Symbols present on only one side are added or removed."""
+        before = _report(_func("Old"), methods=(_func("Add"),))
+        after = _report(_func("New"), methods=(_func("Add"),))
+        diff = ReportDiffer().diff(before, after)
+
+        assert [d.symbol for d in diff.added] == ["New"]
+        assert diff.added[0].before is None
+        assert [d.symbol for d in diff.removed] == ["Old"]
+        assert diff.removed[0].after is None
+        assert diff.changed == ()
+
+    def test_methods_keyed_by_class(self) -> None:
+        """Methods are compared under their ``Class.method`` name."""
+        before = _report(methods=(_func("Add", lines=5),))
+        after = _report(methods=(_func("Add", lines=9),))
+        diff = ReportDiffer().diff(before, after)
+        assert [d.symbol for d in diff.changed] == ["Calc.Add"]
+
+    def test_identical_reports_empty(self) -> None:
+        """Identical snapshots produce an empty diff."""
+        report = _report(_func("Helper"))
+        assert ReportDiffer().diff(report, report).is_empty
+
+
+class TestDiffFile:
+    """Tests for single-file diffing."""
+
+    def test_cc_change_reports_before_and_after(self) -> None:
+        """``Calc.Add`` going from CC 3 to CC 5 is the one changed entry."""
+        before = _report(methods=(_func("Add", cc=3),)).files[0]
+        after = _report(methods=(_func("Add", cc=5),)).files[0]
+        diff = ReportDiffer().diff_file(before, after)
+
+        assert len(diff.changed) == 1
+        change = diff.changed[0]
+        assert change.name == "Calc.Add"
+        assert change.before.cyclomatic_complexity == 3
+        assert change.after.cyclomatic_complexity == 5
+        assert diff.added == diff.removed == ()
+
+    def test_added_and_removed_by_name(self) -> None:
+        """Names only on one side are listed as added or removed."""
+        before = _report(_func("Keep"), _func("Old")).files[0]
+        after = _report(_func("Keep"), _func("New"), methods=(_func("Add"),)).files[0]
+        diff = ReportDiffer().diff_file(before, after)
+
+        assert diff.added == ("Calc.Add", "New")
+        assert diff.removed == ("Old",)
+        assert diff.changed == ()
+
+    def test_moved_function_unchanged(self) -> None:
+        """A function at a new position with the same metrics is not changed."""
+        before = _report(_func("Helper")).files[0]
+        moved = MethodReport(name="Helper", line=30, end_line=34, lines=5)
+        after = _report(moved).files[0]
+        assert ReportDiffer().diff_file(before, after).is_empty
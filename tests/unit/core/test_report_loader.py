@@ -0,0 +1,38 @@
+"""Unit tests for ReportLoader."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.models import RepoReport
+from dev_stats.core.parsers.go_parser import GoParser
+from dev_stats.core.report_loader import ReportLoader
+from dev_stats.output.exporters.json_exporter import JsonExporter
+
+
+class TestReportLoader:
+    """Tests for loading full JSON exports."""
+
+    def test_round_trip_go_fixture(self, tmp_path: Path) -> None:
+        """Files exported by JsonExporter load back unchanged."""
+        fixtures = Path(__file__).resolve().parents[2] / "fixtures"
+        sample = fixtures / "sample_files" / "go" / "sample.go"
+        file_rpt = GoParser().parse(sample, sample.parent)
+        report = RepoReport(root=tmp_path, files=(file_rpt,))
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        (out_path,) = JsonExporter(report=report, config=config).export(tmp_path / "out")
+
+        loaded = ReportLoader().load(out_path)
+        assert loaded.root == tmp_path
+        assert loaded.files[0].classes == file_rpt.classes
+        assert loaded.files[0].functions == file_rpt.functions
+
+    def test_summary_export_rejected(self, tmp_path: Path) -> None:
+        """A summary export has no files and is rejected."""
+        path = tmp_path / "summary.json"
+        path.write_text('{"schema_version": 1, "total_files": 0}')
+        with pytest.raises(ValueError, match="not a full"):
+            ReportLoader().load(path)
@@ -0,0 +1,116 @@
+"""Unit tests for ReportUpdater."""
+
+from __future__ import annotations
+
+from pathlib import Path
+from unittest.mock import MagicMock
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.aggregator import Aggregator
+from dev_stats.core.dispatcher import Dispatcher
+from dev_stats.core.models import CommitRecord, RepoReport
+from dev_stats.core.parser_registry import create_default_registry
+from dev_stats.core.report_updater import ReportUpdater
+from dev_stats.core.scanner import Scanner
+
+_ADD = "package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n"
+_SUB = "package calc\n\nfunc Sub(a, b int) int {\n\treturn a - b\n}\n"
+
+
+def _initial(tmp_path: Path) -> tuple[RepoReport, Dispatcher]:
+    """Write two Go files and build the initial report for *tmp_path*.
+
+    Args:
+        tmp_path: Repository root.
+
+    Returns:
+        The report and the dispatcher that produced it.
+    """
+    (tmp_path / "add.go").write_text(_ADD, encoding="utf-8")
+    (tmp_path / "sub.go").write_text(_SUB, encoding="utf-8")
+    dispatcher = Dispatcher(registry=create_default_registry(), repo_root=tmp_path)
+    files = dispatcher.parse_many([Path("add.go"), Path("sub.go")])
+    return Aggregator().aggregate(files=files, repo_root=tmp_path), dispatcher
+
+
+class TestReportUpdater:
+    """Tests for ``ReportUpdater.apply``."""
+
+    def test_modified_file_is_reparsed(self, tmp_path: Path) -> None:
+        """Editing a file replaces only that file's report."""
+        report, dispatcher = _initial(tmp_path)
+        updater = ReportUpdater(report=report, dispatcher=dispatcher)
+        untouched = next(f for f in report.files if f.path == Path("sub.go"))
+
+        (tmp_path / "add.go").write_text(
+            _ADD + "\nfunc Inc(a int) int {\n\treturn a + 1\n}\n", encoding="utf-8"
+        )
+        updated = updater.apply({tmp_path / "add.go"})
+
+        by_path = {f.path: f for f in updated.files}
+        assert [fn.name for fn in by_path[Path("add.go")].functions] == ["Add", "Inc"]
+        assert by_path[Path("sub.go")] is untouched
+        assert updater.report is updated
+
+    def test_only_changed_paths_are_parsed(self, tmp_path: Path) -> None:
+        """The dispatcher is asked to parse the changed file only."""
+        report, dispatcher = _initial(tmp_path)
+        spy = MagicMock(wraps=dispatcher)
+        updater = ReportUpdater(report=report, dispatcher=spy)
+
+        updater.apply({tmp_path / "add.go"})
+
+        spy.parse.assert_called_once_with(Path("add.go"))
+
+    def test_new_and_deleted_files(self, tmp_path: Path) -> None:
+        """Created files are added and deleted files dropped."""
+        report, dispatcher = _initial(tmp_path)
+        updater = ReportUpdater(report=report, dispatcher=dispatcher)
+
+        (tmp_path / "mul.go").write_text(_ADD.replace("Add", "Mul"), encoding="utf-8")
+        (tmp_path / "sub.go").unlink()
+        updated = updater.apply({tmp_path / "mul.go", tmp_path / "sub.go"})
+
+        assert sorted(f.path.as_posix() for f in updated.files) == ["add.go", "mul.go"]
+        assert updated.languages[0].file_count == 2
+
+    def test_paths_outside_root_ignored(self, tmp_path: Path) -> None:
+        """Events for files outside the repository do not change the report."""
+        report, dispatcher = _initial(tmp_path)
+        updater = ReportUpdater(report=report, dispatcher=dispatcher)
+
+        updated = updater.apply({tmp_path.parent / "elsewhere.go"})
+
+        assert updated.files == report.files
+
+    def test_git_data_preserved(self, tmp_path: Path) -> None:
+        """Non-structural report fields survive an update."""
+        report, dispatcher = _initial(tmp_path)
+        commits = (MagicMock(spec=CommitRecord),)
+        report = RepoReport(root=report.root, files=report.files, commits=commits)
+        updater = ReportUpdater(report=report, dispatcher=dispatcher)
+
+        updated = updater.apply({tmp_path / "add.go"})
+
+        assert updated.commits is commits
+
+    def test_excluded_file_change_ignored(self, tmp_path: Path) -> None:
+        """Changed files the scanner rejects are not added to the report."""
+        report, dispatcher = _initial(tmp_path)
+        config = AnalysisConfig(
+            repo_path=tmp_path, exclude_patterns=("vendor",), exclude_tests=True
+        )
+        scanner = Scanner(repo_path=tmp_path, config=config, extensions=frozenset({".go"}))
+        spy = MagicMock(wraps=dispatcher)
+        updater = ReportUpdater(report=report, dispatcher=spy, scanner=scanner)
+
+        (tmp_path / "vendor").mkdir()
+        (tmp_path / "vendor" / "lib.go").write_text(_ADD, encoding="utf-8")
+        (tmp_path / "add_test.go").write_text(_ADD, encoding="utf-8")
+        (tmp_path / "tool.py").write_text("x = 1\n", encoding="utf-8")
+        updated = updater.apply(
+            {tmp_path / "vendor" / "lib.go", tmp_path / "add_test.go", tmp_path / "tool.py"}
+        )
+
+        assert sorted(f.path.as_posix() for f in updated.files) == ["add.go", "sub.go"]
+        spy.parse.assert_not_called()
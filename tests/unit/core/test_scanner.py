@@ -26,6 +26,112 @@ class TestScannerFindsFiles:
         assert Path("sub/b.py") in found
 
 
+class TestScannerRecursion:
+    """Scanner honours the ``recursive`` flag and is safe against symlink loops."""
+
+    def test_non_recursive_skips_subdirectories(self, tmp_path: Path) -> None:
+        """With ``recursive=False`` only top-level files are yielded."""
+        (tmp_path / "main.go").write_text("package main\n")
+        (tmp_path / "pkg").mkdir()
+        (tmp_path / "pkg" / "util.go").write_text("package pkg\n")
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=(), recursive=False)
+        scanner = Scanner(repo_path=tmp_path, config=config)
+        found = list(scanner.scan())
+
+        assert found == [Path("main.go")]
+
+    def test_recursive_is_default(self, tmp_path: Path) -> None:
+        """Subdirectories are visited by default."""
+        (tmp_path / "pkg").mkdir()
+        (tmp_path / "pkg" / "util.go").write_text("package pkg\n")
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=())
+        scanner = Scanner(repo_path=tmp_path, config=config)
+
+        assert Path("pkg/util.go") in list(scanner.scan())
+
+    def test_symlink_loop_terminates(self, tmp_path: Path) -> None:
+        """A directory symlink pointing at an ancestor is not followed."""
+        pkg = tmp_path / "pkg"
+        pkg.mkdir()
+        (pkg / "util.go").write_text("package pkg\n")
+        (pkg / "loop").symlink_to(tmp_path, target_is_directory=True)
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=())
+        scanner = Scanner(repo_path=tmp_path, config=config)
+        found = list(scanner.scan())
+
+        assert found == [Path("pkg/util.go")]
+
+    def test_test_only_directory_is_scanned(self, tmp_path: Path) -> None:
+        """A directory holding only ``_test.go`` files still yields them."""
+        pkg = tmp_path / "pkg"
+        pkg.mkdir()
+        (pkg / "util_test.go").write_text("package pkg\n")
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=())
+        scanner = Scanner(repo_path=tmp_path, config=config)
+
+        assert list(scanner.scan()) == [Path("pkg/util_test.go")]
+
+
+class TestScannerTestsAndGenerated:
+    """Scanner skips test and generated files only when asked to."""
+
+    _GO_FIXTURES = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files" / "go"
+
+    def test_included_by_default(self) -> None:
+        """Both flags default to ``False``."""
+        config = AnalysisConfig(repo_path=self._GO_FIXTURES, exclude_patterns=())
+        found = set(Scanner(repo_path=self._GO_FIXTURES, config=config).scan())
+
+        assert Path("sample_test.go") in found
+        assert Path("mode_string.go") in found
+
+    def test_exclude_tests(self) -> None:
+        """``exclude_tests`` omits ``_test.go`` files."""
+        config = AnalysisConfig(
+            repo_path=self._GO_FIXTURES, exclude_patterns=(), exclude_tests=True
+        )
+        found = set(Scanner(repo_path=self._GO_FIXTURES, config=config).scan())
+
+        assert Path("sample_test.go") not in found
+        assert Path("sample.go") in found
+        assert Path("mode_string.go") in found
+
+    def test_exclude_generated(self) -> None:
+        """``exclude_generated`` omits files with a ``Code generated`` header."""
+        config = AnalysisConfig(
+            repo_path=self._GO_FIXTURES, exclude_patterns=(), exclude_generated=True
+        )
+        found = set(Scanner(repo_path=self._GO_FIXTURES, config=config).scan())
+
+        assert Path("mode_string.go") not in found
+        assert Path("sample.go") in found
+        assert Path("sample_test.go") in found
+
+    def test_generated_marker_beyond_header_ignored(self, tmp_path: Path) -> None:
+        """A marker after the first 10 lines does not count."""
+        body = "package main\n" + "\n" * 12 + "// Code generated by hand.\n"
+        (tmp_path / "late.go").write_text(body)
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=(), exclude_generated=True)
+        found = list(Scanner(repo_path=tmp_path, config=config).scan())
+
+        assert found == [Path("late.go")]
+
+    def test_exclude_tests_other_languages(self, tmp_path: Path) -> None:
+        """Python and TypeScript test naming conventions are recognised."""
+        for name in ("app.py", "test_app.py", "app.ts", "app.spec.ts"):
+            (tmp_path / name).write_text("\n")
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=(), exclude_tests=True)
+        found = sorted(Scanner(repo_path=tmp_path, config=config).scan())
+
+        assert found == [Path("app.py"), Path("app.ts")]
+
+
 class TestScannerExcludes:
     """Scanner respects exclude patterns."""
 
@@ -71,6 +177,40 @@ class TestScannerExcludes:
         assert not any("__pycache__" in str(p) for p in found)
 
 
+class TestScannerIncludes:
+    """``Scanner.includes`` applies the scan filters to a single path."""
+
+    def test_matches_scan_filters(self, tmp_path: Path) -> None:
+        """Excluded, test and other-language paths are rejected."""
+        config = AnalysisConfig(
+            repo_path=tmp_path, exclude_patterns=("vendor",), exclude_tests=True
+        )
+        scanner = Scanner(repo_path=tmp_path, config=config, extensions=frozenset({".go"}))
+
+        assert scanner.includes(Path("pkg/calc.go"))
+        assert not scanner.includes(Path("vendor/lib.go"))
+        assert not scanner.includes(Path("calc_test.go"))
+        assert not scanner.includes(Path("tool.py"))
+
+    def test_extensions_filter_scan(self, tmp_path: Path) -> None:
+        """Only files with the given suffixes are yielded."""
+        (tmp_path / "main.go").write_text("package main\n")
+        (tmp_path / "tool.py").write_text("x = 1\n")
+
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=())
+        scanner = Scanner(repo_path=tmp_path, config=config, extensions=frozenset({".go"}))
+
+        assert list(scanner.scan()) == [Path("main.go")]
+
+    def test_non_recursive(self, tmp_path: Path) -> None:
+        """Nested paths are rejected when ``recursive`` is off."""
+        config = AnalysisConfig(repo_path=tmp_path, exclude_patterns=(), recursive=False)
+        scanner = Scanner(repo_path=tmp_path, config=config)
+
+        assert scanner.includes(Path("main.go"))
+        assert not scanner.includes(Path("pkg/util.go"))
+
+
 class TestScannerGitignore:
     """Scanner honours .gitignore patterns."""
 
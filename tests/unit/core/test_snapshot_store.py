@@ -0,0 +1,210 @@
+"""Unit tests for SnapshotStore."""
+
+from __future__ import annotations
+
+import dataclasses
+import json
+from datetime import UTC, datetime, timedelta, timezone
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.aggregator import Aggregator
+from dev_stats.core.models import (
+    AnomalySeverity,
+    BranchesReport,
+    BranchReport,
+    BranchStatus,
+    ChangeType,
+    CommitRecord,
+    DeletabilityCategory,
+    DetectedPattern,
+    FileChange,
+    GoModDependency,
+    GoModReport,
+    MergeStatus,
+    RepoReport,
+    SemverTag,
+    TagRecord,
+)
+from dev_stats.core.parsers.go_parser import GoParser
+from dev_stats.core.parsers.python_parser import PythonParser
+from dev_stats.core.snapshot_store import (
+    SNAPSHOT_MAGIC,
+    SNAPSHOT_VERSION,
+    SnapshotStore,
+    SnapshotVersionError,
+)
+
+_FIXTURES = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files"
+
+
+def _make_report() -> RepoReport:
+    """Build a report exercising code, metrics and git sections."""
+    root = _FIXTURES
+    files = [
+        GoParser().parse(root / "go" / "sample.go", root),
+        PythonParser().parse(root / "python" / "sample.py", root),
+    ]
+    when = datetime(2026, 3, 1, 12, 30, tzinfo=timezone(timedelta(hours=2)))
+    commit = CommitRecord(
+        sha="a" * 40,
+        author_name="Ada",
+        author_email="ada@example.com",
+        authored_date=when,
+        committer_name="Ada",
+        committer_email="ada@example.com",
+        committed_date=when,
+        message="Add calculator",
+        files=(
+            FileChange(path="go/sample.go", change_type=ChangeType.ADDED, insertions=40),
+            FileChange(
+                path="python/sample.py",
+                change_type=ChangeType.RENAMED,
+                old_path="sample.py",
+            ),
+        ),
+        insertions=40,
+    )
+    tag = TagRecord(name="v1.2.0", sha="a" * 40, date=when, is_annotated=True)
+    branch = BranchReport(
+        name="feature/x",
+        is_remote=False,
+        last_commit_date=datetime(2026, 1, 5, tzinfo=UTC),
+        last_commit_sha="b" * 40,
+        commits_ahead=2,
+        commits_behind=7,
+        author_name="Ada",
+        author_email="ada@example.com",
+        status=BranchStatus.STALE,
+        merge_status=MergeStatus(merged_into_default=True),
+        deletability_score=0.75,
+        deletability_category=DeletabilityCategory.SAFE,
+    )
+    aggregated = Aggregator().aggregate(
+        files=files,
+        repo_root=root,
+        commits=[commit],
+        tags=[tag],
+        patterns=[
+            DetectedPattern(
+                name="big-commit",
+                description="Large commit",
+                severity=AnomalySeverity.MEDIUM,
+                affected_files=("go/sample.go",),
+            )
+        ],
+    )
+    return dataclasses.replace(
+        aggregated,
+        branches_report=BranchesReport(
+            branches=(branch,),
+            default_branch="main",
+            target_branch="main",
+            total_branches=1,
+            stale_count=1,
+            abandoned_count=0,
+            deletable_count=1,
+        ),
+        semver_tags=(SemverTag(tag=tag, major=1, minor=2, patch=0),),
+        go_module=GoModReport(
+            module_path="example.com/calc",
+            go_version="1.22",
+            dependencies=(GoModDependency(path="golang.org/x/text", version="v0.14.0"),),
+        ),
+    )
+
+
+class TestBinarySnapshot:
+    """Tests for the header-prefixed binary encoding."""
+
+    def test_round_trip_preserves_every_field(self, tmp_path: Path) -> None:
+        """A saved and reloaded report compares equal field by field."""
+        report = _make_report()
+        path = tmp_path / "snap.dss"
+        SnapshotStore().save(path, report)
+        loaded = SnapshotStore().load(path)
+
+        assert loaded == report
+        assert loaded.commits is not None
+        assert loaded.commits[0].authored_date.utcoffset() == timedelta(hours=2)
+        assert loaded.files[0].path == report.files[0].path
+
+    def test_header(self, tmp_path: Path) -> None:
+        """The file starts with the magic number and the version byte."""
+        path = tmp_path / "snap.dss"
+        SnapshotStore().save(path, RepoReport(root=Path("/repo")))
+        data = path.read_bytes()
+        assert int.from_bytes(data[:4], "big") == SNAPSHOT_MAGIC
+        assert data[4] == SNAPSHOT_VERSION
+        assert SnapshotStore.is_binary(path)
+
+    def test_unknown_version_rejected(self, tmp_path: Path) -> None:
+        """A corrupted version byte raises SnapshotVersionError."""
+        path = tmp_path / "snap.dss"
+        SnapshotStore().save(path, RepoReport(root=Path("/repo")))
+        data = bytearray(path.read_bytes())
+        data[4] = SNAPSHOT_VERSION + 1
+        path.write_bytes(bytes(data))
+
+        with pytest.raises(SnapshotVersionError, match="unsupported snapshot version"):
+            SnapshotStore().load(path)
+
+    def test_wrong_magic_rejected(self, tmp_path: Path) -> None:
+        """A file without the magic number is not a snapshot."""
+        path = tmp_path / "other.bin"
+        path.write_bytes(b"PK\x03\x04junk")
+        with pytest.raises(SnapshotVersionError, match="not a dev-stats snapshot"):
+            SnapshotStore().load(path)
+        assert not SnapshotStore.is_binary(path)
+
+    def test_truncated_rejected(self, tmp_path: Path) -> None:
+        """A file shorter than the header is rejected."""
+        path = tmp_path / "short.dss"
+        path.write_bytes(b"DS")
+        with pytest.raises(SnapshotVersionError, match="too short"):
+            SnapshotStore().load(path)
+
+
+class TestJsonSnapshot:
+    """Tests for the JSON encoding."""
+
+    def test_round_trip_preserves_every_field(self, tmp_path: Path) -> None:
+        """The JSON variant round-trips the same report."""
+        report = _make_report()
+        path = tmp_path / "snap.json"
+        SnapshotStore().save_json(path, report)
+
+        assert SnapshotStore().load_json(path) == report
+        document = json.loads(path.read_text(encoding="utf-8"))
+        assert document["snapshot_version"] == SNAPSHOT_VERSION
+        assert document["report"]["files"][0]["language"] == "go"
+
+    def test_version_mismatch_rejected(self, tmp_path: Path) -> None:
+        """A JSON snapshot from another format version is refused."""
+        path = tmp_path / "snap.json"
+        SnapshotStore().save_json(path, RepoReport(root=Path("/repo")))
+        document = json.loads(path.read_text(encoding="utf-8"))
+        document["snapshot_version"] = 99
+        path.write_text(json.dumps(document), encoding="utf-8")
+
+        with pytest.raises(SnapshotVersionError, match="99"):
+            SnapshotStore().load_json(path)
+
+    def test_plain_export_rejected(self, tmp_path: Path) -> None:
+        """A dev-stats.json export is not mistaken for a snapshot."""
+        path = tmp_path / "dev-stats.json"
+        path.write_text('{"schema_version": 1, "files": []}', encoding="utf-8")
+        with pytest.raises(SnapshotVersionError, match="not a dev-stats JSON snapshot"):
+            SnapshotStore().load_json(path)
+
+    def test_malformed_field_rejected(self, tmp_path: Path) -> None:
+        """A field with the wrong JSON shape raises ValueError."""
+        path = tmp_path / "snap.json"
+        SnapshotStore().save_json(path, RepoReport(root=Path("/repo")))
+        document = json.loads(path.read_text(encoding="utf-8"))
+        document["report"]["files"] = {"not": "a list"}
+        path.write_text(json.dumps(document), encoding="utf-8")
+
+        with pytest.raises(ValueError, match="corrupt snapshot"):
+            SnapshotStore().load_json(path)
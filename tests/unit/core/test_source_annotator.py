@@ -0,0 +1,85 @@
+"""Unit tests for SourceAnnotator."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.models import FileReport
+from dev_stats.core.parsers.go_parser import GoParser
+from dev_stats.core.source_annotator import SourceAnnotator
+
+_FIXTURE = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files" / "go" / "sample.go"
+
+
+class TestAnnotate:
+    """Tests for inserting metric comments."""
+
+    def test_fixture_round_trip(self) -> None:
+        """Each signature gets its comment and unannotate restores the bytes."""
+        source = _FIXTURE.read_bytes().decode("utf-8")
+        report = GoParser().parse(_FIXTURE, _FIXTURE.parent)
+        annotated = SourceAnnotator().annotate(source, report)
+
+        lines = annotated.splitlines()
+        for _, func in report.qualified_functions:
+            expected = f" // devstats: cc={func.cyclomatic_complexity} loc={func.lines}"
+            assert lines[func.line - 1].endswith(expected)
+        assert annotated.count("// devstats:") == len(report.qualified_functions)
+        assert SourceAnnotator.unannotate(annotated).encode("utf-8") == _FIXTURE.read_bytes()
+
+    def test_reannotate_replaces_stale_comment(self) -> None:
+        """An existing devstats comment is updated rather than duplicated."""
+        src = "package p\n\nfunc F() { // devstats: cc=9 loc=99\n}\n"
+        report = GoParser().parse_bytes(src.encode(), Path("p.go"))
+        annotated = SourceAnnotator().annotate(src, report)
+        assert annotated == "package p\n\nfunc F() { // devstats: cc=1 loc=2\n}\n"
+
+    def test_crlf_and_other_comments_kept(self) -> None:
+        """Line endings and unrelated trailing comments survive a round trip."""
+        src = "package p\r\n\r\nfunc F() { // keep\r\n}\r\n"
+        report = GoParser().parse_bytes(src.encode(), Path("p.go"))
+        annotated = SourceAnnotator().annotate(src, report)
+        assert annotated.splitlines(keepends=True)[2] == (
+            "func F() { // keep // devstats: cc=1 loc=2\r\n"
+        )
+        assert SourceAnnotator.unannotate(annotated) == src
+
+    def test_func_in_raw_string_untouched(self) -> None:
+        """A ``func`` line inside a raw string is neither annotated nor stripped."""
+        src = (
+            "package p\n\nvar tmpl = `\nfunc Fake() {\n`\n\n"
+            'var note = "x // devstats: cc=1 loc=1"\n\nfunc F() {\n}\n'
+        )
+        report = GoParser().parse_bytes(src.encode(), Path("p.go"))
+        annotated = SourceAnnotator().annotate(src, report)
+        assert annotated.splitlines()[3] == "func Fake() {"
+        assert annotated.count("// devstats:") == 2
+        assert SourceAnnotator.unannotate(annotated) == src
+
+    def test_line_ending_in_literal_rejected(self) -> None:
+        """A signature line that ends inside a raw string is not written to."""
+        src = "package p\n\nfunc F() string { return `a\nb` }\n"
+        report = GoParser().parse_bytes(src.encode(), Path("p.go"))
+        with pytest.raises(ValueError, match="ends inside a literal"):
+            SourceAnnotator().annotate(src, report)
+
+    def test_non_go_rejected(self) -> None:
+        """Only Go files can be annotated."""
+        python = FileReport(
+            path=Path("m.py"),
+            language="python",
+            total_lines=1,
+            code_lines=1,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        with pytest.raises(ValueError, match="only Go files"):
+            SourceAnnotator().annotate("x = 1\n", python)
+
+    def test_stale_report_rejected(self) -> None:
+        """A function past the end of the source is an error."""
+        report = GoParser().parse_bytes(b"package p\n\nfunc F() {\n}\n", Path("p.go"))
+        with pytest.raises(ValueError, match="past the end"):
+            SourceAnnotator().annotate("package p\n", report)
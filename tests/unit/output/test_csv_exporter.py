@@ -14,6 +14,7 @@ from dev_stats.core.models import (
     MethodReport,
     RepoReport,
 )
+from dev_stats.core.parsers.go_parser import GoParser
 from dev_stats.output.exporters.csv_exporter import CsvExporter
 
 
@@ -189,3 +190,48 @@ class TestCsvExporter:
         for p in created:
             rows = _parse_csv(p)
             assert len(rows) == 0
+
+    def test_methods_csv_kind_and_sorted(self, tmp_path: Path) -> None:
+        """Rows carry a kind column and are sorted by file then symbol."""
+        report = _make_report(tmp_path)
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        out_dir = tmp_path / "output"
+        CsvExporter(report=report, config=config).export(out_dir)
+
+        rows = _parse_csv(out_dir / "methods.csv")
+        assert [(r["class"], r["name"], r["kind"]) for r in rows] == [
+            ("Hello", "greet", "method"),
+            ("", "helper", "function"),
+        ]
+
+    def test_methods_csv_new_columns_appended(self, tmp_path: Path) -> None:
+        """The package and kind columns follow the original columns."""
+        report = _make_report(tmp_path)
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        out_dir = tmp_path / "output"
+        CsvExporter(report=report, config=config).export(out_dir)
+
+        header = (out_dir / "methods.csv").read_text().splitlines()[0].split(",")
+        assert header[:3] == ["file", "class", "name"]
+        assert header[-3:] == ["is_constructor", "package", "kind"]
+
+
+class TestCsvExporterRoundTrip:
+    """Round-trip tests against the Go sample fixture."""
+
+    def test_go_fixture_round_trip(self, tmp_path: Path) -> None:
+        """The fixture's Helper row is a package-level function."""
+        fixtures = Path(__file__).resolve().parents[2] / "fixtures"
+        sample = fixtures / "sample_files" / "go" / "sample.go"
+        file_rpt = GoParser().parse(sample, sample.parent)
+        report = RepoReport(root=tmp_path, files=(file_rpt,))
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        out_dir = tmp_path / "output"
+        CsvExporter(report=report, config=config).export(out_dir)
+
+        rows = _parse_csv(out_dir / "methods.csv")
+        by_symbol = {(r["class"], r["name"]): r for r in rows}
+        helper = by_symbol[("", "Helper")]
+        assert helper["kind"] == "function"
+        assert helper["package"] == file_rpt.package
+        assert by_symbol[("Calculator", "Add")]["kind"] == "method"
@@ -0,0 +1,136 @@
+"""Unit tests for HtmlExporter."""
+
+from __future__ import annotations
+
+from html.parser import HTMLParser
+from pathlib import Path
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.models import FileReport, MethodReport, RepoReport
+from dev_stats.core.parsers.go_parser import GoParser
+from dev_stats.output.exporters.html_exporter import HtmlExporter
+
+_FIXTURE = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files" / "go" / "sample.go"
+
+
+class _TableCollector(HTMLParser):
+    """Collect table headers, rows, and cell classes from HTML."""
+
+    def __init__(self) -> None:
+        """Initialise empty collections."""
+        super().__init__()
+        self.headers: list[str] = []
+        self.rows: list[list[str]] = []
+        self.cell_classes: dict[str, str] = {}
+        self.tags: set[str] = set()
+        self._cell: list[str] | None = None
+        self._cell_class = ""
+        self._in_header = False
+
+    def handle_starttag(self, tag: str, attrs: list[tuple[str, str | None]]) -> None:
+        """Open rows and cells."""
+        self.tags.add(tag)
+        if tag == "tr":
+            self.rows.append([])
+        elif tag in ("td", "th"):
+            self._cell = []
+            self._cell_class = dict(attrs).get("class") or ""
+            self._in_header = tag == "th"
+
+    def handle_endtag(self, tag: str) -> None:
+        """Close cells, recording their text and class."""
+        if tag in ("td", "th") and self._cell is not None:
+            text = "".join(self._cell).strip()
+            if self._in_header:
+                self.headers.append(text)
+            else:
+                self.rows[-1].append(text)
+                if self._cell_class:
+                    row = self.rows[-1]
+                    self.cell_classes[f"{row[0]}:{len(row) - 1}"] = self._cell_class
+            self._cell = None
+
+    def handle_data(self, data: str) -> None:
+        """Accumulate text inside the current cell."""
+        if self._cell is not None:
+            self._cell.append(data)
+
+
+def _render(report: RepoReport, tmp_path: Path) -> _TableCollector:
+    """Export *report* to HTML and parse the result."""
+    config = AnalysisConfig.load(repo_path=tmp_path)
+    created = HtmlExporter(report=report, config=config).export(tmp_path / "out")
+    collector = _TableCollector()
+    collector.feed(created[0].read_text(encoding="utf-8"))
+    return collector
+
+
+class TestHtmlExporter:
+    """Tests for the static HTML report."""
+
+    def test_export_creates_file(self, tmp_path: Path) -> None:
+        """export() writes dev-stats-report.html."""
+        report = RepoReport(root=tmp_path, files=())
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        created = HtmlExporter(report=report, config=config).export(tmp_path)
+        assert [p.name for p in created] == ["dev-stats-report.html"]
+
+    def test_self_contained(self, tmp_path: Path) -> None:
+        """The page carries inline CSS and no scripts or external links."""
+        report = RepoReport(root=tmp_path, files=())
+        collector = _render(report, tmp_path)
+        assert "style" in collector.tags
+        assert "script" not in collector.tags
+        assert "link" not in collector.tags
+
+    def test_fixture_tables(self, tmp_path: Path) -> None:
+        """Summary and function tables carry headers and fixture rows."""
+        file_rpt = GoParser().parse(_FIXTURE, _FIXTURE.parent)
+        collector = _render(RepoReport(root=tmp_path, files=(file_rpt,)), tmp_path)
+
+        for header in ("Files", "Lines", "Function", "LOC", "CC", "Cognitive"):
+            assert header in collector.headers
+        assert "details" in collector.tags
+        names = {row[0]: row for row in collector.rows if row}
+        assert names["Calculator.Add"][3] == "3"
+        assert names["Helper"][3] == "1"
+        assert collector.rows[1][0] == "1"  # summary row: one file
+
+    def test_cc_colour_coding(self, tmp_path: Path) -> None:
+        """CC cells are red above the limit, yellow from half of it, green below."""
+        funcs = tuple(
+            MethodReport(name=name, line=i, end_line=i, lines=1, cyclomatic_complexity=cc)
+            for i, (name, cc) in enumerate((("low", 2), ("mid", 5), ("edge", 10), ("hot", 11)))
+        )
+        file_rpt = FileReport(
+            path=Path("a.go"),
+            language="go",
+            total_lines=4,
+            code_lines=4,
+            blank_lines=0,
+            comment_lines=0,
+            functions=funcs,
+        )
+        collector = _render(RepoReport(root=tmp_path, files=(file_rpt,)), tmp_path)
+
+        assert collector.cell_classes["low:3"] == "cc-low"
+        assert collector.cell_classes["mid:3"] == "cc-medium"
+        assert collector.cell_classes["edge:3"] == "cc-medium"
+        assert collector.cell_classes["hot:3"] == "cc-high"
+
+    def test_names_are_escaped(self, tmp_path: Path) -> None:
+        """File paths are HTML-escaped."""
+        file_rpt = FileReport(
+            path=Path("<odd>.go"),
+            language="go",
+            total_lines=0,
+            code_lines=0,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        html = HtmlExporter(
+            report=RepoReport(root=tmp_path, files=(file_rpt,)), config=config
+        ).render()
+        assert "&lt;odd&gt;.go" in html
+        assert "<odd>" not in html
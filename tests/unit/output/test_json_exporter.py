@@ -22,6 +22,7 @@ from dev_stats.core.models import (
     ModuleReport,
     RepoReport,
 )
+from dev_stats.core.parsers.go_parser import GoParser
 from dev_stats.output.exporters.json_exporter import JsonExporter
 
 
@@ -139,6 +140,53 @@ class TestJsonExporterFull:
         assert isinstance(data["root"], str)
         assert isinstance(data["files"][0]["path"], str)
 
+    def test_full_contains_schema_version(self, tmp_path: Path) -> None:
+        """Full export carries the schema version."""
+        report = _make_report(tmp_path)
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        exporter = JsonExporter(report=report, config=config)
+
+        out_dir = tmp_path / "output"
+        exporter.export(out_dir)
+
+        data = json.loads((out_dir / "dev-stats.json").read_text())
+        assert data["schema_version"] == JsonExporter.SCHEMA_VERSION
+
+
+class TestJsonExporterRoundTrip:
+    """Round-trip a parsed fixture through the JSON exporter."""
+
+    def test_go_fixture_round_trip(self, tmp_path: Path) -> None:
+        """Every parsed field of the Go fixture survives export and reload."""
+        fixtures = Path(__file__).resolve().parents[2] / "fixtures"
+        sample = fixtures / "sample_files" / "go" / "sample.go"
+        file_rpt = GoParser().parse(sample, sample.parent)
+        report = RepoReport(root=tmp_path, files=(file_rpt,))
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        exporter = JsonExporter(report=report, config=config)
+
+        out_dir = tmp_path / "output"
+        exporter.export(out_dir)
+
+        data = json.loads((out_dir / "dev-stats.json").read_text())
+        loaded = data["files"][0]
+        assert loaded["path"] == str(file_rpt.path)
+        assert loaded["total_lines"] == file_rpt.total_lines
+        assert loaded["code_lines"] == file_rpt.code_lines
+        assert loaded["imports"] == list(file_rpt.imports)
+        assert [c["name"] for c in loaded["classes"]] == [c.name for c in file_rpt.classes]
+        assert [c["decorators"] for c in loaded["classes"]] == [
+            list(c.decorators) for c in file_rpt.classes
+        ]
+        assert [len(c["methods"]) for c in loaded["classes"]] == [
+            len(c.methods) for c in file_rpt.classes
+        ]
+        loaded_cc = {fn["name"]: fn["cyclomatic_complexity"] for fn in loaded["functions"]}
+        assert loaded_cc == {fn.name: fn.cyclomatic_complexity for fn in file_rpt.functions}
+        for loaded_cls, cls in zip(loaded["classes"], file_rpt.classes, strict=True):
+            loaded_methods = {m["name"]: m["cyclomatic_complexity"] for m in loaded_cls["methods"]}
+            assert loaded_methods == {m.name: m.cyclomatic_complexity for m in cls.methods}
+
 
 class TestJsonExporterSummary:
     """Tests for summary-mode JSON export."""
@@ -242,6 +290,18 @@ class TestJsonExporterSummary:
         data = json.loads((out_dir / "dev-stats-summary.json").read_text())
         assert data["coupling_modules"] == 0
 
+    def test_summary_contains_schema_version(self, tmp_path: Path) -> None:
+        """Summary export carries the schema version."""
+        report = _make_report(tmp_path)
+        config = AnalysisConfig.load(repo_path=tmp_path)
+        exporter = JsonExporter(report=report, config=config, summary=True)
+
+        out_dir = tmp_path / "output"
+        exporter.export(out_dir)
+
+        data = json.loads((out_dir / "dev-stats-summary.json").read_text())
+        assert data["schema_version"] == JsonExporter.SCHEMA_VERSION
+
 
 class TestJsonExporterConversion:
     """Tests for value conversion edge cases."""
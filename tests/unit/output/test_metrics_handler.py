@@ -0,0 +1,59 @@
+"""Unit tests for the ``/metrics`` HTTP handler."""
+
+from __future__ import annotations
+
+import functools
+import threading
+import urllib.error
+import urllib.request
+from http.server import HTTPServer
+from pathlib import Path
+
+import pytest
+
+from dev_stats.core.models import FileReport, MethodReport, RepoReport
+from dev_stats.output.exporters.metrics_handler import MetricsHandler
+from dev_stats.output.exporters.prometheus_exporter import CONTENT_TYPE, render_metrics
+
+
+def _make_report() -> RepoReport:
+    """Build a report with one Go file."""
+    calc = FileReport(
+        path=Path("pkg/calc.go"),
+        language="go",
+        total_lines=10,
+        code_lines=8,
+        blank_lines=2,
+        comment_lines=0,
+        package="calc",
+        functions=(MethodReport(name="Add", line=3, end_line=10, lines=8),),
+    )
+    return RepoReport(root=Path("."), files=(calc,))
+
+
+class TestMetricsHandler:
+    """Tests for :class:`MetricsHandler`."""
+
+    def test_serves_metrics(self) -> None:
+        """GET /metrics returns the current report; other paths are 404."""
+        reports = [_make_report()]
+        handler = functools.partial(MetricsHandler, lambda: reports[-1])
+        server = HTTPServer(("127.0.0.1", 0), handler)
+        thread = threading.Thread(target=server.serve_forever, daemon=True)
+        thread.start()
+        base = f"http://127.0.0.1:{server.server_address[1]}"
+        try:
+            with urllib.request.urlopen(f"{base}/metrics") as response:
+                assert response.headers["Content-Type"] == CONTENT_TYPE
+                assert response.read().decode() == render_metrics(reports[0])
+
+            reports.append(RepoReport(root=Path(".")))
+            with urllib.request.urlopen(f"{base}/metrics") as response:
+                assert "pkg/calc.go" not in response.read().decode()
+
+            with pytest.raises(urllib.error.HTTPError) as excinfo:
+                urllib.request.urlopen(f"{base}/other")
+            assert excinfo.value.code == 404
+        finally:
+            server.shutdown()
+            server.server_close()
@@ -0,0 +1,92 @@
+"""Unit tests for the Prometheus exporter."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.models import ClassReport, FileReport, MethodReport, RepoReport
+from dev_stats.output.exporters.prometheus_exporter import (
+    PrometheusExporter,
+    render_metrics,
+)
+
+
+def _make_report() -> RepoReport:
+    """Build a report with one Go file of known metrics."""
+    calc = FileReport(
+        path=Path("pkg/calc.go"),
+        language="go",
+        total_lines=40,
+        code_lines=30,
+        blank_lines=5,
+        comment_lines=5,
+        package="calc",
+        classes=(
+            ClassReport(
+                name="Calculator",
+                line=3,
+                end_line=20,
+                lines=18,
+                docstring="Calculator adds numbers.",
+                methods=(
+                    MethodReport(name="Add", line=5, end_line=12, lines=8, cyclomatic_complexity=5),
+                ),
+            ),
+        ),
+        functions=(
+            MethodReport(name="Helper", line=22, end_line=30, lines=9, cyclomatic_complexity=2),
+            MethodReport(name="helper", line=32, end_line=40, lines=9, cyclomatic_complexity=1),
+        ),
+    )
+    return RepoReport(root=Path("."), files=(calc,))
+
+
+class TestRenderMetrics:
+    """Tests for the text exposition output."""
+
+    def test_expected_samples(self) -> None:
+        """Each gauge carries package and file labels with the known values."""
+        text = render_metrics(_make_report())
+        labels = 'package="calc",file="pkg/calc.go"'
+        for sample in (
+            f"dev_stats_functions{{{labels}}} 3",
+            f"dev_stats_cyclomatic_complexity_avg{{{labels}}} 2.6666666666666665",
+            f"dev_stats_cyclomatic_complexity_max{{{labels}}} 5",
+            f"dev_stats_lines{{{labels}}} 40",
+            f"dev_stats_undocumented_exports{{{labels}}} 2",
+        ):
+            assert sample in text.splitlines()
+
+    def test_metadata_lines(self) -> None:
+        """Every metric is declared as a gauge with help text."""
+        text = render_metrics(_make_report())
+        assert "# TYPE dev_stats_lines gauge" in text
+        assert "# HELP dev_stats_functions " in text
+        assert text.endswith("\n")
+
+    def test_label_escaping(self) -> None:
+        """Quotes and backslashes in paths are escaped."""
+        f = FileReport(
+            path=Path('odd"name\\.py'),
+            language="python",
+            total_lines=1,
+            code_lines=1,
+            blank_lines=0,
+            comment_lines=0,
+        )
+        text = render_metrics(RepoReport(root=Path("."), files=(f,)))
+        assert 'file="odd\\"name\\\\.py"' in text
+        assert 'package=""' in text
+
+
+class TestPrometheusExporter:
+    """Tests for the file export."""
+
+    def test_writes_prom_file(self, tmp_path: Path) -> None:
+        """export writes dev-stats.prom with the rendered metrics."""
+        report = _make_report()
+        paths = PrometheusExporter(report=report, config=AnalysisConfig()).export(tmp_path)
+        assert paths == [tmp_path / "dev-stats.prom"]
+        assert paths[0].read_text(encoding="utf-8") == render_metrics(report)
+
@@ -0,0 +1,67 @@
+"""Unit tests for TerminalReporter."""
+
+from __future__ import annotations
+
+import io
+import re
+from pathlib import Path
+
+from rich.console import Console
+
+from dev_stats.config.analysis_config import AnalysisConfig
+from dev_stats.core.models import GoModDependency, GoModReport, RepoReport
+from dev_stats.core.parsers.go_parser import GoParser
+from dev_stats.output.exporters.terminal_reporter import TerminalReporter
+
+_FIXTURE = Path(__file__).resolve().parents[2] / "fixtures" / "sample_files" / "go" / "sample.go"
+
+
+def _render(report: RepoReport, tmp_path: Path, top_n: int) -> str:
+    """Print *report* to an in-memory console and return the text."""
+    config = AnalysisConfig.load(repo_path=tmp_path)
+    config = config.model_copy(update={"output": config.output.model_copy(update={"top_n": top_n})})
+    buffer = io.StringIO()
+    console = Console(file=buffer, width=200, color_system=None)
+    TerminalReporter(report=report, config=config, console=console).export(tmp_path)
+    return buffer.getvalue()
+
+
+class TestTerminalReporterMaintainability:
+    """Tests for the lowest-maintainability table."""
+
+    def test_lowest_mi_first_and_limited_to_top_n(self, tmp_path: Path) -> None:
+        """The least maintainable function is listed first, up to top_n rows."""
+        file_report = GoParser().parse(_FIXTURE, _FIXTURE.parent)
+        report = RepoReport(root=_FIXTURE.parent, files=(file_report,))
+        text = _render(report, tmp_path, top_n=2)
+        table = text[text.index("Bottom 2 Functions by MI") :]
+        assert "Calculator.Add" in table
+        assert "63.8" in table
+        assert table.index("Calculator.Add") < table.index("Helper")
+        assert "Calculator.Reset" not in table
+
+    def test_no_table_without_halstead(self, tmp_path: Path) -> None:
+        """Reports without Halstead data print no maintainability table."""
+        report = RepoReport(root=tmp_path)
+        assert "by MI" not in _render(report, tmp_path, top_n=5)
+
+
+class TestTerminalReporterGoModule:
+    """Tests for the Go module table."""
+
+    def test_module_table(self, tmp_path: Path) -> None:
+        """The module path and dependency counts are printed."""
+        go_module = GoModReport(
+            module_path="example.com/app",
+            go_version="1.22",
+            dependencies=(
+                GoModDependency("github.com/a/b", "v1.0.0"),
+                GoModDependency("github.com/c/d", "v0.1.0", indirect=True),
+                GoModDependency("github.com/e/f", "v0.2.0", indirect=True),
+            ),
+        )
+        text = _render(RepoReport(root=tmp_path, go_module=go_module), tmp_path, top_n=5)
+        assert "Go Module example.com/app" in text
+        assert re.search(r"Go version\s*│\s*1\.22\s*│", text)
+        assert re.search(r"Direct dependencies\s*│\s*1\s*│", text)
+        assert re.search(r"Indirect dependencies\s*│\s*2\s*│", text)
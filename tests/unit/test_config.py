@@ -10,6 +10,7 @@ if TYPE_CHECKING:
 import pytest
 from pydantic import ValidationError
 
+from dev_stats.config.analysis_config import AnalysisConfig
 from dev_stats.config.config_loader import ConfigLoader
 from dev_stats.config.threshold_config import ThresholdConfig
 
@@ -30,8 +31,11 @@ class TestThresholdConfigDefaults:
         assert cfg.max_cognitive_complexity == 15
         assert cfg.max_parameters == 5
         assert cfg.max_nesting_depth == 4
+        assert cfg.max_return_values == 3
+        assert cfg.require_default_case is False
         assert cfg.max_class_methods == 20
         assert cfg.max_class_lines == 300
+        assert cfg.max_interface_methods == 10
         assert cfg.max_imports == 15
         assert cfg.min_maintainability_index == 20.0
         assert cfg.max_duplication_pct == 5.0
@@ -139,6 +143,65 @@ class TestLoadToml:
             self.loader.load_toml(tmp_path / "nope.toml")
 
 
+# ---------------------------------------------------------------------------
+# ConfigLoader — find_config
+# ---------------------------------------------------------------------------
+
+
+class TestFindConfig:
+    """Tests for ConfigLoader.find_config and discovery in AnalysisConfig.load."""
+
+    def setup_method(self) -> None:
+        """Create a ConfigLoader instance."""
+        self.loader = ConfigLoader()
+
+    def test_found_in_parent(self, tmp_path: Path) -> None:
+        """A config file in an ancestor below the module root is found."""
+        (tmp_path / "go.mod").write_text("module example.com/m\n")
+        config = tmp_path / ".devstats.toml"
+        config.write_text("")
+        nested = tmp_path / "pkg" / "calc"
+        nested.mkdir(parents=True)
+        assert self.loader.find_config(nested) == config.resolve()
+
+    def test_stops_at_module_root(self, tmp_path: Path) -> None:
+        """Files above the directory holding go.mod are ignored."""
+        (tmp_path / ".devstats.toml").write_text("")
+        module = tmp_path / "module"
+        module.mkdir()
+        (module / "go.mod").write_text("module example.com/m\n")
+        assert self.loader.find_config(module) is None
+
+    def test_no_marker_checks_start_only(self, tmp_path: Path) -> None:
+        """Without go.mod or .git above, parents are not searched."""
+        (tmp_path / ".devstats.toml").write_text("")
+        nested = tmp_path / "pkg"
+        nested.mkdir()
+        assert self.loader.find_config(nested) is None
+        assert self.loader.find_config(tmp_path) == (tmp_path / ".devstats.toml").resolve()
+
+    def test_prefers_dev_stats_name(self, tmp_path: Path) -> None:
+        """.dev-stats.toml wins over .devstats.toml in the same directory."""
+        (tmp_path / ".git").mkdir()
+        (tmp_path / ".devstats.toml").write_text("")
+        (tmp_path / ".dev-stats.toml").write_text("")
+        assert self.loader.find_config(tmp_path) == (tmp_path / ".dev-stats.toml").resolve()
+
+    def test_load_populates_thresholds(self, tmp_path: Path) -> None:
+        """AnalysisConfig.load picks up a discovered config file."""
+        (tmp_path / "go.mod").write_text("module example.com/m\n")
+        (tmp_path / ".devstats.toml").write_text("[thresholds]\nmax_cyclomatic_complexity = 5\n")
+        cfg = AnalysisConfig.load(repo_path=tmp_path)
+        assert cfg.thresholds.max_cyclomatic_complexity == 5
+
+    def test_explicit_args_override_file(self, tmp_path: Path) -> None:
+        """Explicit arguments still win over discovered file values."""
+        (tmp_path / "go.mod").write_text("module example.com/m\n")
+        (tmp_path / ".devstats.toml").write_text("jobs = 4\n")
+        assert AnalysisConfig.load(repo_path=tmp_path).jobs == 4
+        assert AnalysisConfig.load(repo_path=tmp_path, jobs=1).jobs == 1
+
+
 # ---------------------------------------------------------------------------
 # ConfigLoader — apply_env_overrides
 # ---------------------------------------------------------------------------